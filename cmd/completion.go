@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/completion"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
+	"github.com/spf13/cobra"
+)
+
+// completionCmd prints the shell completion script
+var completionCmd = &cobra.Command{
+	Use:   "completion [bash|zsh|fish|powershell]",
+	Short: "Generate shell completion scripts",
+	Long: `Print the completion script for the given shell, or the one in $SHELL when omitted.
+Completion suggests saved task and workspace names with their path and first command.
+
+Run 'vstr completion install' to set it up, or load the script manually:
+	source <(vstr completion bash)`,
+	Args:      cobra.MatchAll(cobra.MaximumNArgs(1), cobra.OnlyValidArgs),
+	ValidArgs: completion.ShellNames(),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := completion.PrintScript(cmd, args); err != nil {
+			styles.PrintError(err.Error())
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	// Replaced by completionCmd, which adds the install helper
+	rootCmd.CompletionOptions.DisableDefaultCmd = true
+
+	rootCmd.AddCommand(completionCmd)
+	completionCmd.AddCommand(completion.InstallCmd)
+}
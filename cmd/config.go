@@ -0,0 +1,23 @@
+package cmd
+
+import (
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/cfg"
+	"github.com/spf13/cobra"
+)
+
+// configCmd represents the config command
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect and change the CLI configuration",
+	Long:  `Read and update the settings stored in config.json. See docs/CONFIGURATION.md for every key.`,
+}
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+
+	configCmd.AddCommand(cfg.GetCMD)
+	configCmd.AddCommand(cfg.SetCMD)
+	configCmd.AddCommand(cfg.ListCMD)
+	configCmd.AddCommand(cfg.EditCMD)
+	configCmd.AddCommand(cfg.ResetCMD)
+}
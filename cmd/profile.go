@@ -0,0 +1,23 @@
+package cmd
+
+import (
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/profile"
+	"github.com/spf13/cobra"
+)
+
+// profileCmd represents the profile command
+var profileCmd = &cobra.Command{
+	Use:   "profile",
+	Short: "Manage profiles with separate task sets",
+	Long:  `Profiles keep separate tasks, workspaces and configuration, e.g. for work and personal projects`,
+}
+
+func init() {
+	rootCmd.AddCommand(profileCmd)
+
+	profileCmd.AddCommand(profile.ListCmd)
+	profileCmd.AddCommand(profile.CreateCmd)
+	profileCmd.AddCommand(profile.UseCmd)
+	profileCmd.AddCommand(profile.DeleteCmd)
+	profileCmd.AddCommand(profile.CopyCmd)
+}
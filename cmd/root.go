@@ -7,6 +7,11 @@ import (
 	"os"
 
 	"github.com/DieGopherLT/vscode-terminal-runner/internal/cfg"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/dashboard"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/paths"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/profile"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/output"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
 	"github.com/spf13/cobra"
 )
 
@@ -22,15 +27,50 @@ Perfect for developers working with microservices, full-stack applications,
 or any multi-project setup.
 
 Examples:
+	vstr                          # Open the dashboard to browse, run and edit everything
 	vstr task create              # Create a new task interactively
 	vstr task run my-backend      # Run a specific task
 	vstr workspace create         # Create a new workspace
 	vstr workspace run my-project # Launch all workspace tasks in VSCode
 
 To get started, run 'vstr setup'.`,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// Redirect every store before any subcommand touches the filesystem
+		if configDir, _ := cmd.Flags().GetString("config-dir"); configDir != "" {
+			paths.SetConfigDir(configDir)
+		}
+		if profileName, _ := cmd.Flags().GetString("profile"); profileName != "" {
+			paths.SetProfile(profileName)
+		}
+
+		outputFormat, _ := cmd.Flags().GetString("output")
+		format, err := output.ParseFormat(outputFormat)
+		if err != nil {
+			return err
+		}
+		output.SetFormat(format)
+
+		// Profile management commands handle missing profiles themselves,
+		// and shell completion must stay silent: a missing profile just completes nothing
+		if cmd.Parent() == profileCmd || isCompletionRequest(cmd) {
+			return nil
+		}
+
+		active, err := paths.ActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		// A missing profile is a user error, not a usage error
+		cmd.SilenceUsage = true
+		return profile.EnsureExists(active)
+	},
 	Run: func(cmd *cobra.Command, args []string) {
-		// Default behavior - show help when no subcommand is provided
-		cmd.Help()
+		// Default behavior - open the dashboard when no subcommand is provided
+		if err := dashboard.Run(dashboard.TasksTab); err != nil {
+			styles.PrintError(err.Error())
+			os.Exit(1)
+		}
 	},
 }
 
@@ -43,6 +83,16 @@ func Execute() {
 	}
 }
 
+// isCompletionRequest reports whether cmd is the hidden command shells call to complete arguments.
+func isCompletionRequest(cmd *cobra.Command) bool {
+	return cmd.Name() == cobra.ShellCompRequestCmd || cmd.Name() == cobra.ShellCompNoDescRequestCmd
+}
+
 func init() {
+	rootCmd.PersistentFlags().String("config-dir", "", "Directory holding tasks, workspaces and config (overrides "+paths.ConfigDirEnv+")")
+	rootCmd.PersistentFlags().StringP("output", "o", string(output.Table), "Output format for list and show commands: table, json, yaml or names")
+	rootCmd.PersistentFlags().String("profile", "", "Profile whose tasks, workspaces and config are used (overrides "+paths.ProfileEnv+")")
+
 	rootCmd.AddCommand(cfg.SetupCMD)
+	rootCmd.AddCommand(cfg.DoctorCMD)
 }
@@ -4,9 +4,11 @@ Copyright © 2025 NAME HERE <EMAIL ADDRESS>
 package cmd
 
 import (
-	"fmt"
+	"os"
 
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/dashboard"
 	"github.com/DieGopherLT/vscode-terminal-runner/internal/task"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
 	"github.com/spf13/cobra"
 )
 
@@ -14,9 +16,12 @@ import (
 var taskCmd = &cobra.Command{
 	Use:   "task",
 	Short: "TUI to manage projects",
-	Long: `Interactive TUI to manage all tasks operations`,
+	Long: `Interactive dashboard to browse, run, edit, duplicate and delete tasks`,
 	Run: func(cmd *cobra.Command, args []string) {
-		fmt.Println("Interactive TUI to manage all tasks operations")
+		if err := dashboard.Run(dashboard.TasksTab); err != nil {
+			styles.PrintError(err.Error())
+			os.Exit(1)
+		}
 	},
 }
 
@@ -25,7 +30,10 @@ func init() {
 	
 	taskCmd.AddCommand(task.CreateCmd)
 	taskCmd.AddCommand(task.ListCmd)
+	taskCmd.AddCommand(task.ShowCmd)
 	taskCmd.AddCommand(task.DeleteCmd)
 	taskCmd.AddCommand(task.EditCmd)
+	taskCmd.AddCommand(task.SetCmd)
+	taskCmd.AddCommand(task.RenameCmd)
 	taskCmd.AddCommand(task.RunCmd)
 }
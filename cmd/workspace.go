@@ -17,5 +17,12 @@ func init() {
 	
 	workspaceCmd.AddCommand(workspace.CreateCmd)
 	workspaceCmd.AddCommand(workspace.ListCmd)
+	workspaceCmd.AddCommand(workspace.ShowCmd)
+	workspaceCmd.AddCommand(workspace.EditCmd)
+	workspaceCmd.AddCommand(workspace.RenameCmd)
+	workspaceCmd.AddCommand(workspace.DeleteCmd)
+	workspaceCmd.AddCommand(workspace.AddTaskCmd)
+	workspaceCmd.AddCommand(workspace.RemoveTaskCmd)
+	workspaceCmd.AddCommand(workspace.MoveTaskCmd)
 	workspaceCmd.AddCommand(workspace.RunCmd)
 }
\ No newline at end of file
@@ -2,6 +2,7 @@ package cfg
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
 	"github.com/spf13/cobra"
@@ -24,3 +25,133 @@ var SetupCMD = &cobra.Command{
 		return err
 	},
 }
+
+var DoctorCMD = &cobra.Command{
+	Use:   "doctor",
+	Short: "Diagnose the editor CLI and VSCode extension installation",
+	Long:  `Check the configuration, the editor CLI and the VSTR-Bridge extension version, offering to upgrade outdated extensions`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		problems, err := Doctor()
+		if err != nil {
+			return err
+		}
+		if problems > 0 {
+			styles.PrintWarning(fmt.Sprintf("Found %d problem(s).", problems))
+			return nil
+		}
+		styles.PrintSuccess("No problems found.")
+		return nil
+	},
+}
+
+// GetCMD prints the value of a single configuration key.
+var GetCMD = &cobra.Command{
+	Use:   "get <key>",
+	Short: "Print a configuration value",
+	Long:  `Print the current value of a configuration key. Run 'vstr config list' to see every key.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := Load()
+		if err != nil {
+			return err
+		}
+
+		field, err := FindConfigField(args[0])
+		if err != nil {
+			styles.PrintError(err.Error())
+			return nil
+		}
+
+		fmt.Println(field.Get(config))
+		return nil
+	},
+}
+
+// SetCMD updates a single configuration key after validating the new value.
+var SetCMD = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Set a configuration value",
+	Long:  `Set a configuration key to a new value. The whole configuration is validated before saving.`,
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := Load()
+		if err != nil {
+			return err
+		}
+
+		if err := SetValue(&config, args[0], args[1]); err != nil {
+			if isConfigError(err) {
+				styles.PrintError(err.Error())
+				return nil
+			}
+			return err
+		}
+
+		if err := Save(config); err != nil {
+			return err
+		}
+		styles.PrintSuccess(fmt.Sprintf("%s set to '%s'", args[0], args[1]))
+		return nil
+	},
+}
+
+// ListCMD prints every configuration key with its value and description.
+var ListCMD = &cobra.Command{
+	Use:   "list",
+	Short: "List all configuration values",
+	Long:  `List every configuration key with its current value. Values that differ from the default are marked with '*'.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := Load()
+		if err != nil {
+			return err
+		}
+
+		listConfig(config)
+		return nil
+	},
+}
+
+// EditCMD opens the configuration file in $VISUAL or $EDITOR.
+var EditCMD = &cobra.Command{
+	Use:   "edit",
+	Short: "Edit the configuration file",
+	Long:  `Open the configuration file in $VISUAL or $EDITOR and validate it once the editor exits`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := editConfig(); err != nil {
+			if isConfigError(err) {
+				styles.PrintError(err.Error())
+				styles.PrintInfo("Run 'vstr config edit' again to retry, or restore defaults with 'vstr config reset'.")
+				return nil
+			}
+			return err
+		}
+
+		styles.PrintSuccess("Configuration is valid.")
+		return nil
+	},
+}
+
+// ResetCMD restores configuration keys to their default values.
+var ResetCMD = &cobra.Command{
+	Use:   "reset [key...]",
+	Short: "Restore default configuration values",
+	Long:  `Restore the given keys to their default values. Without arguments every key except the setup state is reset.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := Load()
+		if err != nil {
+			return err
+		}
+
+		config, err = resetConfig(config, args)
+		if err != nil {
+			styles.PrintError(err.Error())
+			return nil
+		}
+
+		if err := Save(config); err != nil {
+			return err
+		}
+		styles.PrintSuccess("Configuration reset to defaults.")
+		return nil
+	},
+}
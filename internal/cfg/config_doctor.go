@@ -0,0 +1,61 @@
+package cfg
+
+import (
+	"fmt"
+	"os/exec"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/version"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
+)
+
+// Doctor inspects the local installation and returns the number of problems found.
+// Outdated extensions are offered an upgrade along the way.
+func Doctor() (int, error) {
+	problems := 0
+
+	configFile, err := ConfigurationFile()
+	if err != nil {
+		return 0, err
+	}
+
+	// Read the file as written, so invalid values are reported rather than replaced by defaults
+	config, err := readConfigFile()
+	if err != nil {
+		return 0, fmt.Errorf("failed to load configuration: %w", err)
+	}
+	styles.PrintSuccess(fmt.Sprintf("Configuration loaded from %s", configFile))
+
+	if err := Validate(config); err != nil {
+		styles.PrintError(err.Error())
+		problems++
+	}
+
+	if !config.IsSetupComplete {
+		styles.PrintWarning("Setup has not been completed. Run 'vstr setup'.")
+		problems++
+	}
+
+	editorCLI := EditorCLIFor(config)
+	if _, err := exec.LookPath(editorCLI); err != nil {
+		styles.PrintError(fmt.Sprintf("Editor CLI '%s' not found in PATH", editorCLI))
+		return problems + 1, nil
+	}
+	styles.PrintSuccess(fmt.Sprintf("Editor CLI '%s' found", editorCLI))
+
+	installed, ok := installedExtensionVersion(editorCLI)
+	if !ok {
+		styles.PrintError("VSTR-Bridge extension is not installed")
+		styles.PrintInfo("Run 'vstr setup' or install it from: https://github.com/DieGopherLT/VSTR-Bridge")
+		return problems + 1, nil
+	}
+
+	if isExtensionOutdated(installed) {
+		if !offerExtensionUpgrade(editorCLI, installed) {
+			problems++
+		}
+		return problems, nil
+	}
+
+	styles.PrintSuccess(fmt.Sprintf("VSTR-Bridge %s installed (minimum %s)", installed, version.MinExtensionVersion))
+	return problems, nil
+}
@@ -0,0 +1,87 @@
+package cfg
+
+import (
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/prompt"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/samber/lo"
+)
+
+// DefaultEditorCLI is the editor command used when none has been configured.
+const DefaultEditorCLI = "code"
+
+// EditorCLI describes a VSCode-compatible editor command line interface.
+type EditorCLI struct {
+	Command string // Binary name looked up in PATH
+	Name    string // Human readable editor name
+}
+
+// SupportedEditors lists every editor CLI able to install the VSTR-Bridge extension.
+var SupportedEditors = []EditorCLI{
+	{Command: "code", Name: "Visual Studio Code"},
+	{Command: "code-insiders", Name: "Visual Studio Code - Insiders"},
+	{Command: "codium", Name: "VSCodium"},
+	{Command: "cursor", Name: "Cursor"},
+}
+
+// DetectEditors returns the supported editor CLIs that are available in PATH.
+func DetectEditors() []EditorCLI {
+	return lo.Filter(SupportedEditors, func(editor EditorCLI, _ int) bool {
+		_, err := exec.LookPath(editor.Command)
+		return err == nil
+	})
+}
+
+// EditorCLIFor returns the editor command configured by the user, falling back to DefaultEditorCLI.
+func EditorCLIFor(config models.Config) string {
+	if config.EditorCLI == "" {
+		return DefaultEditorCLI
+	}
+	return config.EditorCLI
+}
+
+// chooseEditor detects installed editor CLIs and lets the user pick one when several are available.
+func chooseEditor() (EditorCLI, error) {
+	editors := DetectEditors()
+
+	switch len(editors) {
+	case 0:
+		commands := lo.Map(SupportedEditors, func(editor EditorCLI, _ int) string { return editor.Command })
+		return EditorCLI{}, fmt.Errorf("no supported editor CLI found in PATH (%s)", strings.Join(commands, ", "))
+	case 1:
+		styles.PrintInfo(fmt.Sprintf("Detected %s (%s)", editors[0].Name, editors[0].Command))
+		return editors[0], nil
+	}
+
+	return editors[getEditorChoice(editors)], nil
+}
+
+// getEditorChoice prompts the user to pick one of the detected editors and returns its index.
+func getEditorChoice(editors []EditorCLI) int {
+	titleStyle := lipgloss.NewStyle().
+		Foreground(styles.VSCodeBlue).
+		Bold(true)
+
+	commandStyle := lipgloss.NewStyle().
+		Foreground(styles.LightGray)
+
+	fmt.Println(titleStyle.Render("Multiple editors detected:"))
+	for i, editor := range editors {
+		fmt.Printf("  %d. %s %s\n", i+1, editor.Name, commandStyle.Render("("+editor.Command+")"))
+	}
+
+	answer := prompt.Ask("Which editor should vstr use?", fmt.Sprintf("[1-%d, default 1]: ", len(editors)))
+
+	choice, err := strconv.Atoi(answer)
+	if err != nil || choice < 1 || choice > len(editors) {
+		return 0
+	}
+
+	return choice - 1
+}
@@ -0,0 +1,113 @@
+package cfg
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/version"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
+)
+
+// defaultExtensionName is the marketplace identifier of the VSTR-Bridge extension.
+const defaultExtensionName = "DieGopherLT.vstr-bridge"
+
+// extensionName returns the extension identifier, overridable through VSTR_EXTENSION_NAME.
+func extensionName() string {
+	if name := os.Getenv("VSTR_EXTENSION_NAME"); name != "" {
+		return name
+	}
+	return defaultExtensionName
+}
+
+// installedExtensionVersion returns the installed VSTR-Bridge version for the given editor CLI.
+// The boolean is false when the extension is not installed or the CLI could not be run.
+func installedExtensionVersion(editorCLI string) (string, bool) {
+	cmd := exec.Command(editorCLI, "--list-extensions", "--show-versions")
+	output, err := cmd.Output()
+	if err != nil {
+		return "", false
+	}
+
+	return parseExtensionVersion(string(output), extensionName())
+}
+
+// parseExtensionVersion finds the version of extensionID in `--show-versions` output,
+// where every line has the form "publisher.name@1.2.3".
+func parseExtensionVersion(output, extensionID string) (string, bool) {
+	for _, line := range strings.Split(output, "\n") {
+		id, ver, found := strings.Cut(strings.TrimSpace(line), "@")
+		if !strings.EqualFold(id, extensionID) {
+			continue
+		}
+		if !found {
+			return "", true
+		}
+		return ver, true
+	}
+	return "", false
+}
+
+// isExtensionOutdated reports whether the installed version is older than version.MinExtensionVersion.
+// Versions that cannot be parsed are considered outdated.
+func isExtensionOutdated(installed string) bool {
+	upToDate, err := version.AtLeast(installed, version.MinExtensionVersion)
+	return err != nil || !upToDate
+}
+
+// checkExtensionUpgrade checks the installed extension version and offers to upgrade it when outdated.
+// It returns true when the extension is installed and up to date after the check.
+func checkExtensionUpgrade(editorCLI string) bool {
+	installed, ok := installedExtensionVersion(editorCLI)
+	if !ok {
+		return false
+	}
+	return offerExtensionUpgrade(editorCLI, installed)
+}
+
+// offerExtensionUpgrade offers to upgrade the installed extension version when it is outdated.
+// It returns true when the extension is up to date after the offer.
+func offerExtensionUpgrade(editorCLI, installed string) bool {
+	if !isExtensionOutdated(installed) {
+		return true
+	}
+
+	styles.PrintWarning(fmt.Sprintf("VSTR-Bridge %s is installed but %s or newer is required.", displayVersion(installed), version.MinExtensionVersion))
+	styles.PrintInfo("Outdated extensions may cause authentication or protocol errors.")
+
+	switch promptYesNo("Would you like to upgrade the extension now?") {
+	case "y", "yes", "":
+		if err := upgradeExtension(editorCLI); err != nil {
+			styles.PrintError(fmt.Sprintf("Failed to upgrade extension: %v", err))
+			return false
+		}
+		return true
+	default:
+		styles.PrintInfo(fmt.Sprintf("You can upgrade later with '%s --install-extension %s --force'.", editorCLI, extensionName()))
+		return false
+	}
+}
+
+// upgradeExtension reinstalls the latest extension release through the given editor CLI.
+func upgradeExtension(editorCLI string) error {
+	styles.PrintProgress("Upgrading VSTR-Bridge extension...")
+
+	cmd := exec.Command(editorCLI, "--install-extension", extensionName(), "--force")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("upgrade failed: %w\nOutput: %s", err, string(output))
+	}
+
+	styles.PrintSuccess("Extension upgraded successfully!")
+	styles.PrintInfo("Please restart your editor to activate the new version.")
+	return nil
+}
+
+// displayVersion renders an installed version for user-facing messages.
+func displayVersion(installed string) string {
+	if installed == "" {
+		return "(unknown version)"
+	}
+	return installed
+}
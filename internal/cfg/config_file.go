@@ -3,43 +3,49 @@ package cfg
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
-	"path"
+	"path/filepath"
 
 	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/paths"
 )
 
-var (
-	ConfigurationFile string
-)
+// ConfigurationFile returns the path of the configuration file for the active config directory.
+func ConfigurationFile() (string, error) {
+	return paths.ConfigFile()
+}
 
-func init() {
-	cfgDir, err := os.UserConfigDir()
+// Load reads the configuration file, filling missing keys with DefaultConfig values.
+// Values Validate would reject, such as a zero timeout, are replaced by their default as well.
+// A missing file is not an error: it is created lazily by Save.
+func Load() (models.Config, error) {
+	config, err := readConfigFile()
 	if err != nil {
-		panic("could not determine user config directory: " + err.Error())
+		return models.Config{}, err
 	}
+	return withValidDefaults(config), nil
+}
 
-	ConfigurationFile = path.Join(cfgDir, "vscode-terminal-runner", "config.json")
+// readConfigFile reads the configuration file as written, filling only the missing keys.
+// Doctor and editConfig use it to report invalid values instead of hiding them.
+func readConfigFile() (models.Config, error) {
+	config := DefaultConfig()
 
-	if _, err := os.Stat(ConfigurationFile); errors.Is(err, os.ErrNotExist) {
-		if err := os.MkdirAll(path.Dir(ConfigurationFile), 0755); err != nil {
-			panic("could not create config directory: " + err.Error())
-		}
-		if _, err := os.Create(ConfigurationFile); err != nil {
-			panic("could not create config file: " + err.Error())
-		}
+	configFile, err := ConfigurationFile()
+	if err != nil {
+		return models.Config{}, err
 	}
-}
 
-func Load() (models.Config, error) {
-	file, err := os.Open(ConfigurationFile)
+	file, err := os.Open(configFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return config, nil
+	}
 	if err != nil {
 		return models.Config{}, err
 	}
 	defer file.Close()
 
-	var config models.Config
-
 	// Get file information to check if file is empty
 	fileInfo, err := file.Stat()
 	if err != nil {
@@ -48,7 +54,7 @@ func Load() (models.Config, error) {
 
 	// If file is empty, return default config
 	if fileInfo.Size() == 0 {
-		return models.Config{}, nil
+		return config, nil
 	}
 
 	if err := json.NewDecoder(file).Decode(&config); err != nil {
@@ -57,3 +63,38 @@ func Load() (models.Config, error) {
 
 	return config, nil
 }
+
+// LoadOrDefault loads the configuration, falling back to DefaultConfig when it cannot be read.
+func LoadOrDefault() models.Config {
+	config, err := Load()
+	if err != nil {
+		return DefaultConfig()
+	}
+	return config
+}
+
+// Save persists the given configuration to the configuration file.
+func Save(config models.Config) error {
+	configFile, err := ConfigurationFile()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(configFile), 0755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	file, err := os.Create(configFile)
+	if err != nil {
+		return fmt.Errorf("failed to create config file: %w", err)
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(config); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	return nil
+}
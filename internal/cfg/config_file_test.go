@@ -0,0 +1,84 @@
+package cfg
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/paths"
+)
+
+// writeConfigFile points the stores at a temporary directory and writes content as the configuration file.
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+	t.Setenv(paths.ConfigDirEnv, t.TempDir())
+	t.Setenv(paths.ProfileEnv, "")
+
+	configFile, err := ConfigurationFile()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(configFile), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(configFile, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return configFile
+}
+
+func TestLoad_InvalidValuesFallBackToDefaults(t *testing.T) {
+	// Arrange
+	writeConfigFile(t, `{"connect_timeout": 0, "task_timeout": -5, "workspace_timeout": 90, "default_icon": "rockett", "path_bookmarks": ["~/code", "relative"]}`)
+	defaults := DefaultConfig()
+
+	// Act
+	config, err := Load()
+
+	// Assert
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.ConnectTimeout != defaults.ConnectTimeout || config.TaskTimeout != defaults.TaskTimeout {
+		t.Errorf("expected default timeouts, got connect %d and task %d", config.ConnectTimeout, config.TaskTimeout)
+	}
+	if config.WorkspaceTimeout != 90 {
+		t.Errorf("expected the valid workspace timeout to be kept, got %d", config.WorkspaceTimeout)
+	}
+	if config.DefaultIcon != defaults.DefaultIcon {
+		t.Errorf("expected the default icon, got %q", config.DefaultIcon)
+	}
+	if len(config.PathBookmarks) != 1 || config.PathBookmarks[0] != "~/code" {
+		t.Errorf("expected only the valid bookmark, got %v", config.PathBookmarks)
+	}
+	if Validate(config) != nil {
+		t.Errorf("expected the loaded configuration to be valid, got %v", Validate(config))
+	}
+}
+
+func TestEditConfig_InvalidEditIsDiscarded(t *testing.T) {
+	// Arrange
+	configFile := writeConfigFile(t, `{"connect_timeout": 15}`)
+	editor := filepath.Join(t.TempDir(), "editor.sh")
+	script := "#!/bin/sh\necho '{\"connect_timeout\": 0}' > \"$1\"\n"
+	if err := os.WriteFile(editor, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("VISUAL", editor)
+
+	// Act
+	err := editConfig()
+
+	// Assert
+	if !errors.Is(err, ErrInvalidConfig) {
+		t.Fatalf("expected ErrInvalidConfig, got %v", err)
+	}
+	config, err := readConfigFile()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.ConnectTimeout != 15 {
+		t.Errorf("expected the previous configuration to be restored, got connect_timeout %d (%s)", config.ConnectTimeout, configFile)
+	}
+}
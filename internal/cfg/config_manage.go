@@ -0,0 +1,115 @@
+package cfg
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+)
+
+// listConfig prints every configuration key with its current value and description.
+func listConfig(config models.Config) {
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	defer writer.Flush()
+
+	defaults := DefaultConfig()
+
+	fmt.Fprintln(writer, "Key\tValue\tDescription")
+	for _, field := range ConfigFields {
+		value := field.Get(config)
+		if value == "" {
+			value = "-"
+		}
+		if field.Get(config) != field.Get(defaults) {
+			value += " *"
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\n", field.Key, value, field.Description)
+	}
+}
+
+// resetConfig restores the given keys to their defaults, or every key except the setup state when none are given.
+func resetConfig(config models.Config, keys []string) (models.Config, error) {
+	if len(keys) == 0 {
+		defaults := DefaultConfig()
+		defaults.IsSetupComplete = config.IsSetupComplete
+		return defaults, nil
+	}
+
+	for _, key := range keys {
+		if err := ResetValue(&config, key); err != nil {
+			return config, err
+		}
+	}
+	return config, nil
+}
+
+// editConfig opens the configuration file in the user's editor and validates the result.
+// An invalid result is discarded and the previous contents are restored.
+func editConfig() error {
+	// Make sure the file exists and contains every documented key before editing
+	config, err := Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+	if err := Save(config); err != nil {
+		return err
+	}
+
+	configFile, err := ConfigurationFile()
+	if err != nil {
+		return err
+	}
+
+	previous, err := os.ReadFile(configFile)
+	if err != nil {
+		return err
+	}
+
+	// $EDITOR may carry arguments, e.g. "code --wait"
+	editor := strings.Fields(textEditor())
+	cmd := exec.Command(editor[0], append(editor[1:], configFile)...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to run editor '%s': %w", editor[0], err)
+	}
+
+	edited, err := readConfigFile()
+	if err == nil {
+		err = Validate(edited)
+	} else {
+		err = fmt.Errorf("%w: %v", ErrInvalidConfig, err)
+	}
+	if err == nil {
+		return nil
+	}
+
+	if restoreErr := os.WriteFile(configFile, previous, 0644); restoreErr != nil {
+		return errors.Join(err, fmt.Errorf("failed to restore the previous configuration: %w", restoreErr))
+	}
+	return fmt.Errorf("%w (changes discarded, previous configuration restored)", err)
+}
+
+// textEditor returns the editor from $VISUAL or $EDITOR, falling back to a platform default.
+func textEditor() string {
+	for _, env := range []string{"VISUAL", "EDITOR"} {
+		if editor := strings.TrimSpace(os.Getenv(env)); editor != "" {
+			return editor
+		}
+	}
+	if runtime.GOOS == "windows" {
+		return "notepad"
+	}
+	return "vi"
+}
+
+// isConfigError reports whether err was caused by an invalid key or value.
+func isConfigError(err error) bool {
+	return errors.Is(err, ErrUnknownConfigKey) || errors.Is(err, ErrInvalidConfig)
+}
@@ -0,0 +1,287 @@
+package cfg
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
+	"github.com/samber/lo"
+)
+
+var (
+	ErrUnknownConfigKey = errors.New("unknown configuration key")
+	ErrInvalidConfig    = errors.New("invalid configuration")
+)
+
+// DefaultConfig returns the configuration used when no value has been set by the user.
+func DefaultConfig() models.Config {
+	return models.Config{
+		IsSetupComplete:   false,
+		EditorCLI:         DefaultEditorCLI,
+		BridgeDir:         "",
+		ConnectTimeout:    30,
+		TaskTimeout:       60,
+		WorkspaceTimeout:  120,
+		DefaultIcon:       "terminal",
+		DefaultIconColor:  "terminal.ansiGreen",
+		SuggestionHistory: true,
+		PathSearchRoots:   []string{},
+		PathBookmarks:     []string{},
+		PathSuggestFiles:  false,
+	}
+}
+
+// ConfigField describes a single user-editable configuration key.
+type ConfigField struct {
+	Key         string // JSON key, also used by 'vstr config get/set'
+	Description string // Human readable description shown by 'vstr config list'
+	get         func(models.Config) string
+	set         func(*models.Config, string) error
+}
+
+// Get returns the string representation of the field value in config.
+func (f ConfigField) Get(config models.Config) string {
+	return f.get(config)
+}
+
+// ConfigFields lists every configuration key in display order.
+var ConfigFields = []ConfigField{
+	{
+		Key:         "is_setup_complete",
+		Description: "Whether 'vstr setup' has completed",
+		get:         func(c models.Config) string { return strconv.FormatBool(c.IsSetupComplete) },
+		set: func(c *models.Config, v string) (err error) {
+			c.IsSetupComplete, err = strconv.ParseBool(v)
+			return err
+		},
+	},
+	{
+		Key:         "editor_cli",
+		Description: "Editor CLI used to manage the extension (code, code-insiders, codium, cursor)",
+		get:         func(c models.Config) string { return c.EditorCLI },
+		set:         func(c *models.Config, v string) error { c.EditorCLI = v; return nil },
+	},
+	{
+		Key:         "bridge_dir",
+		Description: "Directory where VSTR-Bridge writes its bridge files (empty for the platform default)",
+		get:         func(c models.Config) string { return c.BridgeDir },
+		set:         func(c *models.Config, v string) error { c.BridgeDir = v; return nil },
+	},
+	{
+		Key:         "connect_timeout",
+		Description: "Seconds allowed to handshake with the bridge",
+		get:         func(c models.Config) string { return strconv.Itoa(c.ConnectTimeout) },
+		set:         func(c *models.Config, v string) error { return parseSeconds(v, &c.ConnectTimeout) },
+	},
+	{
+		Key:         "task_timeout",
+		Description: "Seconds allowed to launch a single task",
+		get:         func(c models.Config) string { return strconv.Itoa(c.TaskTimeout) },
+		set:         func(c *models.Config, v string) error { return parseSeconds(v, &c.TaskTimeout) },
+	},
+	{
+		Key:         "workspace_timeout",
+		Description: "Seconds allowed to launch a whole workspace",
+		get:         func(c models.Config) string { return strconv.Itoa(c.WorkspaceTimeout) },
+		set:         func(c *models.Config, v string) error { return parseSeconds(v, &c.WorkspaceTimeout) },
+	},
+	{
+		Key:         "default_icon",
+		Description: "Icon pre-filled when creating tasks",
+		get:         func(c models.Config) string { return c.DefaultIcon },
+		set:         func(c *models.Config, v string) error { c.DefaultIcon = v; return nil },
+	},
+	{
+		Key:         "default_icon_color",
+		Description: "Icon color pre-filled when creating tasks",
+		get:         func(c models.Config) string { return c.DefaultIconColor },
+		set:         func(c *models.Config, v string) error { c.DefaultIconColor = v; return nil },
+	},
+	{
+		Key:         "suggestion_history",
+		Description: "Record the paths, icons, colors and commands of saved tasks to rank suggestions",
+		get:         func(c models.Config) string { return strconv.FormatBool(c.SuggestionHistory) },
+		set: func(c *models.Config, v string) (err error) {
+			c.SuggestionHistory, err = strconv.ParseBool(v)
+			return err
+		},
+	},
+	{
+		Key:         "path_search_roots",
+		Description: "Comma-separated directories searched for git repositories to suggest as task paths (e.g. ~/code)",
+		get:         func(c models.Config) string { return strings.Join(c.PathSearchRoots, ",") },
+		set:         func(c *models.Config, v string) error { c.PathSearchRoots = parseList(v); return nil },
+	},
+	{
+		Key:         "path_bookmarks",
+		Description: "Comma-separated paths always suggested in the task path field",
+		get:         func(c models.Config) string { return strings.Join(c.PathBookmarks, ",") },
+		set:         func(c *models.Config, v string) error { c.PathBookmarks = parseList(v); return nil },
+	},
+	{
+		Key:         "path_suggest_files",
+		Description: "Also suggest files in the task path field, for tasks that point at a script",
+		get:         func(c models.Config) string { return strconv.FormatBool(c.PathSuggestFiles) },
+		set: func(c *models.Config, v string) (err error) {
+			c.PathSuggestFiles, err = strconv.ParseBool(v)
+			return err
+		},
+	},
+}
+
+// FindConfigField returns the field registered under key.
+func FindConfigField(key string) (ConfigField, error) {
+	field, found := lo.Find(ConfigFields, func(f ConfigField) bool {
+		return f.Key == key
+	})
+	if !found {
+		return ConfigField{}, fmt.Errorf("%w: %s", ErrUnknownConfigKey, key)
+	}
+	return field, nil
+}
+
+// SetValue parses value into the field registered under key and validates the resulting configuration.
+func SetValue(config *models.Config, key, value string) error {
+	field, err := FindConfigField(key)
+	if err != nil {
+		return err
+	}
+
+	updated := *config
+	if err := field.set(&updated, strings.TrimSpace(value)); err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidConfig, key, err)
+	}
+
+	if err := Validate(updated); err != nil {
+		return err
+	}
+
+	*config = updated
+	return nil
+}
+
+// ResetValue restores the default value of the field registered under key.
+func ResetValue(config *models.Config, key string) error {
+	field, err := FindConfigField(key)
+	if err != nil {
+		return err
+	}
+	return field.set(config, field.get(DefaultConfig()))
+}
+
+// Validate checks that every configuration value is within its allowed range.
+func Validate(config models.Config) error {
+	var problems []string
+
+	if strings.TrimSpace(config.EditorCLI) == "" {
+		problems = append(problems, "editor_cli must not be empty")
+	}
+
+	if config.BridgeDir != "" && !filepath.IsAbs(config.BridgeDir) {
+		problems = append(problems, "bridge_dir must be an absolute path")
+	}
+
+	timeouts := []struct {
+		key     string
+		seconds int
+	}{
+		{"connect_timeout", config.ConnectTimeout},
+		{"task_timeout", config.TaskTimeout},
+		{"workspace_timeout", config.WorkspaceTimeout},
+	}
+	for _, timeout := range timeouts {
+		if timeout.seconds <= 0 {
+			problems = append(problems, timeout.key+" must be a positive number of seconds")
+		}
+	}
+
+	if !lo.ContainsBy(styles.VSCodeIcons, func(i styles.VSCodeIcon) bool { return i.Name == config.DefaultIcon }) {
+		problems = append(problems, fmt.Sprintf("default_icon '%s' is not a known VSCode icon", config.DefaultIcon))
+	}
+
+	if !lo.ContainsBy(styles.VSCodeANSIColors, func(c styles.VSCodeANSIColor) bool { return c.Name == config.DefaultIconColor }) {
+		problems = append(problems, fmt.Sprintf("default_icon_color '%s' is not a known terminal color", config.DefaultIconColor))
+	}
+
+	for _, list := range []struct {
+		key   string
+		paths []string
+	}{
+		{"path_search_roots", config.PathSearchRoots},
+		{"path_bookmarks", config.PathBookmarks},
+	} {
+		for _, path := range list.paths {
+			if !filepath.IsAbs(path) && path != "~" && !strings.HasPrefix(path, "~/") {
+				problems = append(problems, fmt.Sprintf("%s entry '%s' must be an absolute path or start with ~/", list.key, path))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidConfig, strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+// withValidDefaults replaces every value Validate would reject with its DefaultConfig value,
+// so a hand-edited file cannot break every run, e.g. with a zero timeout.
+func withValidDefaults(config models.Config) models.Config {
+	defaults := DefaultConfig()
+
+	if strings.TrimSpace(config.EditorCLI) == "" {
+		config.EditorCLI = defaults.EditorCLI
+	}
+	if config.BridgeDir != "" && !filepath.IsAbs(config.BridgeDir) {
+		config.BridgeDir = defaults.BridgeDir
+	}
+	if config.ConnectTimeout <= 0 {
+		config.ConnectTimeout = defaults.ConnectTimeout
+	}
+	if config.TaskTimeout <= 0 {
+		config.TaskTimeout = defaults.TaskTimeout
+	}
+	if config.WorkspaceTimeout <= 0 {
+		config.WorkspaceTimeout = defaults.WorkspaceTimeout
+	}
+	if _, found := styles.FindIcon(config.DefaultIcon); !found {
+		config.DefaultIcon = defaults.DefaultIcon
+	}
+	if _, found := styles.FindANSIColor(config.DefaultIconColor); !found {
+		config.DefaultIconColor = defaults.DefaultIconColor
+	}
+
+	isValidPath := func(path string, _ int) bool {
+		return filepath.IsAbs(path) || path == "~" || strings.HasPrefix(path, "~/")
+	}
+	config.PathSearchRoots = lo.Filter(config.PathSearchRoots, isValidPath)
+	config.PathBookmarks = lo.Filter(config.PathBookmarks, isValidPath)
+
+	return config
+}
+
+// Seconds converts a timeout expressed in seconds to a time.Duration.
+func Seconds(seconds int) time.Duration {
+	return time.Duration(seconds) * time.Second
+}
+
+// parseList splits a comma-separated value into its trimmed, non-empty items.
+func parseList(value string) []string {
+	items := lo.Map(strings.Split(value, ","), func(item string, _ int) string { return strings.TrimSpace(item) })
+	return lo.Compact(items)
+}
+
+// parseSeconds parses a positive integer number of seconds into target.
+func parseSeconds(value string, target *int) error {
+	seconds, err := strconv.Atoi(value)
+	if err != nil {
+		return fmt.Errorf("expected a number of seconds, got '%s'", value)
+	}
+	*target = seconds
+	return nil
+}
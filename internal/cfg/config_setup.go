@@ -1,16 +1,14 @@
 package cfg
 
 import (
-	"bufio"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"os"
 	"os/exec"
 	"strings"
 	"time"
 
 	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/prompt"
 	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -28,6 +26,7 @@ func Setup() error {
 	}
 
 	if config.IsSetupComplete {
+		checkExtensionUpgrade(EditorCLIFor(config))
 		return ErrSetupCompleted
 	}
 
@@ -35,10 +34,19 @@ func Setup() error {
 	fmt.Print(getWelcomeMessage())
 	time.Sleep(1500 * time.Millisecond)
 
+	// Pick the editor CLI used to manage the extension
+	editor, err := chooseEditor()
+	if err != nil {
+		styles.PrintError(err.Error())
+		return ErrSetupFailed
+	}
+	config.EditorCLI = editor.Command
+
 	// Check if extension is already installed
-	if isExtensionInstalled() {
+	if installed, ok := installedExtensionVersion(editor.Command); ok {
 		styles.PrintSuccess("VSTR-Bridge extension is already installed!")
-		return completeSetup()
+		offerExtensionUpgrade(editor.Command, installed)
+		return completeSetup(config)
 	}
 
 	// Show extension requirement information
@@ -50,12 +58,12 @@ func Setup() error {
 	switch choice {
 	case "y", "yes", "":
 		// Install the extension
-		if err := installExtension(); err != nil {
+		if err := installExtension(editor.Command); err != nil {
 			styles.PrintError(fmt.Sprintf("Failed to install extension: %v", err))
 			styles.PrintInfo("You can install it manually from: https://github.com/DieGopherLT/VSTR-Bridge")
 			return err
 		}
-		return completeSetup()
+		return completeSetup(config)
 
 	default:
 		styles.PrintWarning("The VSTR-Bridge extension is required for this CLI to work.")
@@ -126,19 +134,11 @@ func getExtensionRequirement() string {
 }
 
 // completeSetup marks the setup as complete and saves the configuration.
-func completeSetup() error {
-	config := models.Config{
-		IsSetupComplete: true,
-	}
-
-	file, err := os.Create(ConfigurationFile)
-	if err != nil {
-		return fmt.Errorf("failed to create config file: %w", err)
-	}
-	defer file.Close()
+func completeSetup(config models.Config) error {
+	config.IsSetupComplete = true
 
-	if err := json.NewEncoder(file).Encode(config); err != nil {
-		return fmt.Errorf("failed to save config: %w", err)
+	if err := Save(config); err != nil {
+		return err
 	}
 
 	styles.PrintSuccess("Setup completed successfully!")
@@ -147,27 +147,11 @@ func completeSetup() error {
 	return nil
 }
 
-// isExtensionInstalled checks if the VSCode extension is already installed.
-func isExtensionInstalled() bool {
-	cmd := exec.Command("code", "--list-extensions")
-	output, err := cmd.Output()
-	if err != nil {
-		return false
-	}
-
-	installedExtensions := string(output)
-	return strings.Contains(strings.ToLower(installedExtensions), "diegopherlt.vstr-bridge")
-}
-
-// installExtension handles the interactive installation of the VSCode extension.
-func installExtension() error {
+// installExtension handles the interactive installation of the VSCode extension through the given editor CLI.
+func installExtension(editorCLI string) error {
 	styles.PrintProgress("Installing VSTR-Bridge extension...")
 
-	extensionName := os.Getenv("VSTR_EXTENSION_NAME")
-	if extensionName == "" {
-		extensionName = "DieGopherLT.vstr-bridge"
-	}
-	cmd := exec.Command("code", "--install-extension", extensionName)
+	cmd := exec.Command(editorCLI, "--install-extension", extensionName())
 	output, err := cmd.CombinedOutput()
 
 	if err != nil {
@@ -177,7 +161,7 @@ func installExtension() error {
 	outputStr := string(output)
 	if strings.Contains(outputStr, "successfully installed") || strings.Contains(outputStr, "already installed") {
 		styles.PrintSuccess("Extension installed successfully!")
-		styles.PrintInfo("Please restart VSCode to activate the extension.")
+		styles.PrintInfo("Please restart your editor to activate the extension.")
 		return nil
 	}
 
@@ -189,22 +173,10 @@ func installExtension() error {
 
 // getInstallationChoice prompts the user for installation choice with better UX.
 func getInstallationChoice() string {
-	promptStyle := lipgloss.NewStyle().
-		Foreground(styles.VSCodeBlue).
-		Bold(true)
-
-	optionsStyle := lipgloss.NewStyle().
-		Foreground(styles.LightGray)
-
-	fmt.Print(promptStyle.Render("Would you like to install the extension now?"))
-	fmt.Print(" ")
-	fmt.Print(optionsStyle.Render("[Y/n]: "))
-
-	reader := bufio.NewReader(os.Stdin)
-	input, err := reader.ReadString('\n')
-	if err != nil {
-		return "n"
-	}
+	return promptYesNo("Would you like to install the extension now?")
+}
 
-	return strings.ToLower(strings.TrimSpace(input))
+// promptYesNo asks a yes/no question and returns the lowercased answer.
+func promptYesNo(question string) string {
+	return prompt.Ask(question, "[Y/n]: ")
 }
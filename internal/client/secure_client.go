@@ -22,11 +22,12 @@ type SecureClient struct {
 	baseURL     string
 }
 
-// NewSecureClient creates a new secure client for bridge communication
-func NewSecureClient(port int) *SecureClient {
+// NewSecureClient creates a new secure client for bridge communication.
+// The timeout bounds every single request sent to the bridge.
+func NewSecureClient(port int, timeout time.Duration) *SecureClient {
 	return &SecureClient{
 		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout: timeout,
 			Transport: &http.Transport{
 				DisableKeepAlives:   true,
 				MaxIdleConns:        1,
@@ -44,11 +45,20 @@ func (c *SecureClient) LoadAuth(bridgeFilePath string) error {
 	return c.authManager.LoadTokenFromBridge(bridgeFilePath)
 }
 
-// TestConnection verifies connectivity and authentication with bridge
-func (c *SecureClient) TestConnection(ctx context.Context) error {
+// PingResponse holds the bridge details reported by the /ping endpoint
+type PingResponse struct {
+	Status          string   `json:"status"`
+	Secure          bool     `json:"secure"`
+	Features        []string `json:"security_features"`
+	Version         string   `json:"version"`          // Extension version, empty on older bridges
+	ProtocolVersion string   `json:"protocol_version"` // Bridge protocol version, empty on older bridges
+}
+
+// TestConnection verifies connectivity and authentication with bridge and returns its ping details
+func (c *SecureClient) TestConnection(ctx context.Context) (*PingResponse, error) {
 	req, err := http.NewRequestWithContext(ctx, "GET", c.baseURL+"/ping", nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	
 	// Add authentication headers
@@ -58,38 +68,34 @@ func (c *SecureClient) TestConnection(ctx context.Context) error {
 	
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("connection failed: %w", err)
+		return nil, fmt.Errorf("connection failed: %w", err)
 	}
 	defer resp.Body.Close()
 	
 	if resp.StatusCode == 401 {
-		return fmt.Errorf("authentication failed - invalid token")
+		return nil, fmt.Errorf("authentication failed - invalid token")
 	}
 	
 	if resp.StatusCode == 429 {
-		return fmt.Errorf("rate limit exceeded - too many requests")
+		return nil, fmt.Errorf("rate limit exceeded - too many requests")
 	}
 	
 	if resp.StatusCode != 200 {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
 	}
 	
 	// Verify bridge responds as secure
-	var pingResp struct {
-		Status   string   `json:"status"`
-		Secure   bool     `json:"secure"`
-		Features []string `json:"security_features"`
-	}
+	var pingResp PingResponse
 	
 	if err := json.NewDecoder(resp.Body).Decode(&pingResp); err != nil {
-		return fmt.Errorf("invalid ping response: %w", err)
+		return nil, fmt.Errorf("invalid ping response: %w", err)
 	}
 	
 	if !pingResp.Secure {
-		return fmt.Errorf("bridge is not running in secure mode")
+		return nil, fmt.Errorf("bridge is not running in secure mode")
 	}
 	
-	return nil
+	return &pingResp, nil
 }
 
 // ExecuteTask sends a task for secure execution
@@ -0,0 +1,168 @@
+// Package completion provides shell completion for task and workspace names
+// and installs the completion scripts for the supported shells.
+package completion
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/paths"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/repository"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+)
+
+// maxDescriptionLength keeps completion descriptions on a single terminal line.
+const maxDescriptionLength = 60
+
+// TaskNames completes a single task name argument.
+func TaskNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completeTasks(cmd, args, toComplete)
+}
+
+// TaskNamesMulti completes any number of task name arguments, skipping the ones already given.
+func TaskNamesMulti(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return completeTasks(cmd, args, toComplete)
+}
+
+// WorkspaceNames completes a single workspace name argument.
+func WorkspaceNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	applyStoreFlags(cmd)
+	workspaces, err := repository.ReadWorkspaces()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	return WorkspaceCompletions(workspaces, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// WorkspaceThenTasks completes a workspace name first, then the saved tasks not in that workspace yet.
+func WorkspaceThenTasks(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) == 0 {
+		return WorkspaceNames(cmd, args, toComplete)
+	}
+
+	applyStoreFlags(cmd)
+	tasks, err := repository.ReadTasks()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	given := args[1:]
+	if workspace, err := repository.FindWorkspaceByName(args[0]); err == nil {
+		given = append(given, repository.TaskNames(workspace.Tasks)...)
+	}
+
+	return TaskCompletions(tasks, given, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// WorkspaceThenItsTasks completes a workspace name first, then the tasks inside that workspace.
+func WorkspaceThenItsTasks(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) == 0 {
+		return WorkspaceNames(cmd, args, toComplete)
+	}
+
+	applyStoreFlags(cmd)
+	workspace, err := repository.FindWorkspaceByName(args[0])
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return TaskCompletions(workspace.Tasks, args[1:], toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// WorkspaceThenOneTask is WorkspaceThenItsTasks limited to a single task argument.
+func WorkspaceThenOneTask(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 1 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return WorkspaceThenItsTasks(cmd, args, toComplete)
+}
+
+// TagNames completes the tags used by saved tasks.
+func TagNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	applyStoreFlags(cmd)
+	tags, err := repository.AllTags()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	return lo.Filter(tags, func(tag string, _ int) bool {
+		return hasPrefixFold(tag, toComplete)
+	}), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeTasks loads the tasks of the selected profile and completes their names.
+func completeTasks(cmd *cobra.Command, given []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	applyStoreFlags(cmd)
+	tasks, err := repository.ReadTasks()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	return TaskCompletions(tasks, given, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// TaskCompletions returns "name\tdescription" entries for the tasks whose name starts with toComplete
+// (case-insensitive), leaving out the names in given. The description is the path and the first command.
+func TaskCompletions(tasks []models.Task, given []string, toComplete string) []string {
+	candidates := lo.Filter(tasks, func(task models.Task, _ int) bool {
+		alreadyGiven := lo.ContainsBy(given, func(name string) bool { return strings.EqualFold(name, task.Name) })
+		return !alreadyGiven && hasPrefixFold(task.Name, toComplete)
+	})
+
+	return lo.Map(candidates, func(task models.Task, _ int) string {
+		parts := lo.Compact([]string{task.Path, lo.FirstOr(task.Cmds, "")})
+		return task.Name + "\t" + shortDescription(strings.Join(parts, " · "))
+	})
+}
+
+// WorkspaceCompletions returns "name\tdescription" entries for the workspaces whose name starts
+// with toComplete (case-insensitive). The description lists the workspace's tasks.
+func WorkspaceCompletions(workspaces []models.Workspace, toComplete string) []string {
+	candidates := lo.Filter(workspaces, func(ws models.Workspace, _ int) bool {
+		return hasPrefixFold(ws.Name, toComplete)
+	})
+
+	return lo.Map(candidates, func(ws models.Workspace, _ int) string {
+		description := fmt.Sprintf("%d task(s): %s", len(ws.Tasks), strings.Join(repository.TaskNames(ws.Tasks), ", "))
+		return ws.Name + "\t" + shortDescription(description)
+	})
+}
+
+// applyStoreFlags honors --config-dir and --profile, since completion bypasses the root pre-run hook.
+func applyStoreFlags(cmd *cobra.Command) {
+	if configDir, _ := cmd.Flags().GetString("config-dir"); configDir != "" {
+		paths.SetConfigDir(configDir)
+	}
+	if profileName, _ := cmd.Flags().GetString("profile"); profileName != "" {
+		paths.SetProfile(profileName)
+	}
+}
+
+// hasPrefixFold reports whether s starts with prefix, ignoring case.
+func hasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
+
+// shortDescription replaces the home directory with ~ and cuts descriptions longer than maxDescriptionLength.
+func shortDescription(description string) string {
+	if home := os.Getenv("HOME"); home != "" {
+		description = strings.ReplaceAll(description, home, "~")
+	}
+
+	runes := []rune(description)
+	if len(runes) <= maxDescriptionLength {
+		return description
+	}
+	return string(runes[:maxDescriptionLength-1]) + "…"
+}
@@ -0,0 +1,51 @@
+package completion
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
+	"github.com/spf13/cobra"
+)
+
+// InstallCmd writes the completion script to the standard location for the user's shell.
+var InstallCmd = &cobra.Command{
+	Use:       "install [bash|zsh|fish|powershell]",
+	Short:     "Install shell completion",
+	Long:      `Install the completion script for the given shell, or the one in $SHELL when omitted`,
+	Args:      cobra.MatchAll(cobra.MaximumNArgs(1), cobra.OnlyValidArgs),
+	ValidArgs: ShellNames(),
+	Run: func(cmd *cobra.Command, args []string) {
+		shell, err := shellFromArgs(args)
+		if err != nil {
+			styles.PrintError(err.Error())
+			os.Exit(1)
+		}
+
+		scriptPath, err := Install(cmd.Root(), shell)
+		if err != nil {
+			styles.PrintError(fmt.Sprintf("Failed to install %s completion: %v", shell, err))
+			os.Exit(1)
+		}
+
+		styles.PrintSuccess(fmt.Sprintf("Installed %s completion to %s", shell, scriptPath))
+		styles.PrintInfo(ActivationHint(shell, scriptPath))
+	},
+}
+
+// PrintScript writes the completion script for the shell in args (or $SHELL) to stdout.
+func PrintScript(cmd *cobra.Command, args []string) error {
+	shell, err := shellFromArgs(args)
+	if err != nil {
+		return err
+	}
+	return WriteScript(cmd.Root(), shell, os.Stdout)
+}
+
+// shellFromArgs returns the shell named in args, detecting it when no argument is given.
+func shellFromArgs(args []string) (Shell, error) {
+	if len(args) == 0 {
+		return DetectShell()
+	}
+	return ParseShell(args[0])
+}
@@ -0,0 +1,62 @@
+package completion
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+)
+
+func TestTaskCompletions(t *testing.T) {
+	tasks := []models.Task{
+		{Name: "api", Path: "/srv/api", Cmds: []string{"go run .", "echo done"}},
+		{Name: "Admin", Cmds: []string{"npm start"}},
+		{Name: "web", Path: "/srv/web"},
+	}
+
+	tests := []struct {
+		name       string
+		given      []string
+		toComplete string
+		expected   []string
+	}{
+		{
+			name:       "description has path and first command",
+			toComplete: "ap",
+			expected:   []string{"api\t/srv/api · go run ."},
+		},
+		{
+			name:       "prefix is case-insensitive",
+			toComplete: "a",
+			expected:   []string{"api\t/srv/api · go run .", "Admin\tnpm start"},
+		},
+		{
+			name:       "given names are skipped",
+			given:      []string{"API"},
+			toComplete: "a",
+			expected:   []string{"Admin\tnpm start"},
+		},
+		{
+			name:       "task without commands shows only the path",
+			toComplete: "w",
+			expected:   []string{"web\t/srv/web"},
+		},
+		{
+			name:       "no match",
+			toComplete: "z",
+			expected:   []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			result := TaskCompletions(tasks, tt.given, tt.toComplete)
+
+			// Assert
+			if !slices.Equal(result, tt.expected) {
+				t.Errorf("expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
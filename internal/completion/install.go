@@ -0,0 +1,138 @@
+package completion
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+)
+
+// Shell identifies a shell supported by the completion scripts.
+type Shell string
+
+const (
+	Bash       Shell = "bash"
+	Zsh        Shell = "zsh"
+	Fish       Shell = "fish"
+	PowerShell Shell = "powershell"
+)
+
+// Shells lists every supported shell.
+var Shells = []Shell{Bash, Zsh, Fish, PowerShell}
+
+// ShellNames returns the supported shells as strings, e.g. for cobra ValidArgs.
+func ShellNames() []string {
+	return lo.Map(Shells, func(shell Shell, _ int) string { return string(shell) })
+}
+
+// ParseShell converts a shell name (or a path such as /bin/zsh) into a Shell.
+func ParseShell(name string) (Shell, error) {
+	base := strings.ToLower(filepath.Base(strings.TrimSpace(name)))
+	base = strings.TrimSuffix(base, ".exe")
+
+	switch base {
+	case "bash", "zsh", "fish", "powershell":
+		return Shell(base), nil
+	case "pwsh":
+		return PowerShell, nil
+	}
+	return "", fmt.Errorf("unsupported shell '%s' (expected bash, zsh, fish or powershell)", name)
+}
+
+// DetectShell guesses the user's shell from $SHELL, defaulting to PowerShell on Windows.
+func DetectShell() (Shell, error) {
+	if shell := os.Getenv("SHELL"); shell != "" {
+		return ParseShell(shell)
+	}
+	if runtime.GOOS == "windows" {
+		return PowerShell, nil
+	}
+	return "", errors.New("could not detect the shell from $SHELL; pass it explicitly")
+}
+
+// WriteScript writes the completion script for shell, including task and workspace descriptions.
+func WriteScript(root *cobra.Command, shell Shell, w io.Writer) error {
+	switch shell {
+	case Bash:
+		return root.GenBashCompletionV2(w, true)
+	case Zsh:
+		return root.GenZshCompletion(w)
+	case Fish:
+		return root.GenFishCompletion(w, true)
+	case PowerShell:
+		return root.GenPowerShellCompletionWithDesc(w)
+	}
+	return fmt.Errorf("unsupported shell '%s'", shell)
+}
+
+// ScriptPath returns where Install writes the script for shell. Bash and fish load
+// scripts from these directories automatically; zsh and PowerShell need a line in their startup file.
+func ScriptPath(root *cobra.Command, shell Shell) (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+
+	dataHome := lo.CoalesceOrEmpty(os.Getenv("XDG_DATA_HOME"), filepath.Join(home, ".local", "share"))
+	configHome := lo.CoalesceOrEmpty(os.Getenv("XDG_CONFIG_HOME"), filepath.Join(home, ".config"))
+	name := root.Name()
+
+	switch shell {
+	case Bash:
+		return filepath.Join(dataHome, "bash-completion", "completions", name), nil
+	case Zsh:
+		return filepath.Join(dataHome, "zsh", "site-functions", "_"+name), nil
+	case Fish:
+		return filepath.Join(configHome, "fish", "completions", name+".fish"), nil
+	case PowerShell:
+		if runtime.GOOS == "windows" {
+			return filepath.Join(home, "Documents", "PowerShell", name+"-completion.ps1"), nil
+		}
+		return filepath.Join(configHome, "powershell", name+"-completion.ps1"), nil
+	}
+	return "", fmt.Errorf("unsupported shell '%s'", shell)
+}
+
+// Install writes the completion script for shell and returns its path.
+func Install(root *cobra.Command, shell Shell) (string, error) {
+	scriptPath, err := ScriptPath(root, shell)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(scriptPath), 0755); err != nil {
+		return "", fmt.Errorf("failed to create completion directory: %w", err)
+	}
+
+	file, err := os.Create(scriptPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to create completion script: %w", err)
+	}
+	defer file.Close()
+
+	if err := WriteScript(root, shell, file); err != nil {
+		return "", fmt.Errorf("failed to write completion script: %w", err)
+	}
+	return scriptPath, nil
+}
+
+// ActivationHint explains what is still needed for the installed script to load, if anything.
+func ActivationHint(shell Shell, scriptPath string) string {
+	switch shell {
+	case Bash:
+		return "Completion loads automatically in new shells when the bash-completion package is installed."
+	case Zsh:
+		return fmt.Sprintf("Add this to ~/.zshrc before compinit, then restart the shell:\n  fpath=(%s $fpath)", filepath.Dir(scriptPath))
+	case Fish:
+		return "Completion loads automatically in new fish shells."
+	case PowerShell:
+		return fmt.Sprintf("Add this line to your PowerShell profile ($PROFILE), then restart the shell:\n  . '%s'", scriptPath)
+	}
+	return ""
+}
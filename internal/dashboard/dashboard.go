@@ -0,0 +1,376 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/repository"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/vscode"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/samber/lo"
+)
+
+// Tab identifies the list shown by the dashboard.
+type Tab int
+
+const (
+	TasksTab      Tab = iota // Saved tasks
+	WorkspacesTab            // Saved workspaces
+)
+
+// bridgeRefreshInterval is how often the footer checks the bridge status again.
+const bridgeRefreshInterval = 10 * time.Second
+
+// actionKind is an action the dashboard hands over to Run, because it needs the terminal for itself.
+type actionKind int
+
+const (
+	noAction   actionKind = iota
+	runAction             // Launch the task or workspace in VSCode
+	editAction            // Open the edit form
+)
+
+// pendingAction is the action chosen when the dashboard quit.
+type pendingAction struct {
+	kind actionKind
+	tab  Tab
+	name string
+}
+
+// deletion holds the item waiting for confirmation in the delete modal.
+type deletion struct {
+	tab        Tab
+	name       string
+	workspaces []string // Workspaces that lose the task when it is deleted
+}
+
+// bridgeStatusMsg reports the bridge found by checkBridge, nil when none responds.
+type bridgeStatusMsg struct {
+	bridge *vscode.BridgeInfo
+}
+
+// bridgeRefreshMsg asks for a new bridge check.
+type bridgeRefreshMsg struct{}
+
+// Model is the Bubble Tea model of the dashboard.
+type Model struct {
+	tab           Tab
+	tasks         []models.Task
+	workspaces    []models.Workspace
+	visible       []string // Names shown in the current tab, after filtering
+	cursor        int
+	filter        textinput.Model
+	filtering     bool
+	confirm       *deletion
+	bridge        *vscode.BridgeInfo
+	bridgeChecked bool
+	status        string
+	statusIsError bool
+	action        pendingAction
+}
+
+// New creates a dashboard opened on tab, loading the saved tasks and workspaces.
+func New(tab Tab) (*Model, error) {
+	filter := textinput.New()
+	filter.Placeholder = "Filter by name, #tag, path..."
+	filter.Prompt = "/ "
+	filter.CharLimit = 50
+
+	m := &Model{tab: tab, filter: filter}
+	if err := m.reload(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Init starts the first bridge status check.
+func (m *Model) Init() tea.Cmd {
+	return checkBridge
+}
+
+// checkBridge looks for a responding bridge without connecting to it, preferring the most recent instance.
+func checkBridge() tea.Msg {
+	bridges, err := vscode.ListAvailableBridges()
+	if err != nil || len(bridges) == 0 {
+		return bridgeStatusMsg{}
+	}
+
+	latest := lo.MaxBy(bridges, func(a, b vscode.BridgeInfo) bool {
+		return a.InstanceID > b.InstanceID
+	})
+	return bridgeStatusMsg{bridge: &latest}
+}
+
+// Update handles bridge status updates and key presses.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case bridgeStatusMsg:
+		m.bridge = msg.bridge
+		m.bridgeChecked = true
+		return m, tea.Tick(bridgeRefreshInterval, func(time.Time) tea.Msg { return bridgeRefreshMsg{} })
+	case bridgeRefreshMsg:
+		return m, checkBridge
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			return m, tea.Quit
+		}
+		if m.confirm != nil {
+			return m.handleConfirmKey(msg)
+		}
+		if m.filtering {
+			return m.handleFilterKey(msg)
+		}
+		return m.handleKey(msg)
+	}
+	return m, nil
+}
+
+// handleKey handles the list hotkeys.
+func (m *Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q":
+		return m, tea.Quit
+	case "esc":
+		// Esc clears an applied filter first, then quits
+		if m.filter.Value() == "" {
+			return m, tea.Quit
+		}
+		m.filter.SetValue("")
+		m.applyFilter()
+	case "tab", "shift+tab":
+		m.switchTab(1 - m.tab)
+	case "1":
+		m.switchTab(TasksTab)
+	case "2":
+		m.switchTab(WorkspacesTab)
+	case "up", "k":
+		m.moveCursor(-1)
+	case "down", "j":
+		m.moveCursor(1)
+	case "/":
+		m.filtering = true
+		m.filter.Focus()
+		return m, textinput.Blink
+	case "enter", "r":
+		return m.quitWith(runAction)
+	case "e":
+		return m.quitWith(editAction)
+	case "c":
+		m.duplicateSelected()
+	case "d", "delete":
+		m.askDelete()
+	}
+	return m, nil
+}
+
+// handleFilterKey edits the filter query. Arrows keep moving through the results.
+func (m *Model) handleFilterKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.filter.SetValue("")
+		m.stopFiltering()
+		return m, nil
+	case "enter":
+		m.stopFiltering()
+		return m, nil
+	case "up":
+		m.moveCursor(-1)
+		return m, nil
+	case "down":
+		m.moveCursor(1)
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.filter, cmd = m.filter.Update(msg)
+	m.applyFilter()
+	return m, cmd
+}
+
+// handleConfirmKey answers the delete modal.
+func (m *Model) handleConfirmKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch strings.ToLower(msg.String()) {
+	case "y":
+		m.deleteConfirmed()
+	case "n", "esc", "q":
+		m.confirm = nil
+	}
+	return m, nil
+}
+
+// stopFiltering leaves the filter input, keeping the current query applied.
+func (m *Model) stopFiltering() {
+	m.filtering = false
+	m.filter.Blur()
+	m.applyFilter()
+}
+
+// switchTab shows another tab, keeping the filter query.
+func (m *Model) switchTab(tab Tab) {
+	m.tab = tab
+	m.cursor = 0
+	m.applyFilter()
+}
+
+// moveCursor moves the selection, wrapping around the visible list.
+func (m *Model) moveCursor(direction int) {
+	if len(m.visible) == 0 {
+		return
+	}
+	m.cursor = (m.cursor + direction + len(m.visible)) % len(m.visible)
+}
+
+// selectedName returns the name under the cursor, or "" when the list is empty.
+func (m *Model) selectedName() string {
+	if m.cursor < 0 || m.cursor >= len(m.visible) {
+		return ""
+	}
+	return m.visible[m.cursor]
+}
+
+// focus moves the cursor to the named item when it is visible.
+func (m *Model) focus(name string) {
+	if index := lo.IndexOf(m.visible, name); index != -1 {
+		m.cursor = index
+	}
+}
+
+// quitWith leaves the dashboard so that Run performs kind on the selected item.
+func (m *Model) quitWith(kind actionKind) (tea.Model, tea.Cmd) {
+	name := m.selectedName()
+	if name == "" {
+		return m, nil
+	}
+	m.action = pendingAction{kind: kind, tab: m.tab, name: name}
+	return m, tea.Quit
+}
+
+// duplicateSelected saves a copy of the selected item and selects the copy.
+func (m *Model) duplicateSelected() {
+	name := m.selectedName()
+	if name == "" {
+		return
+	}
+
+	var copyName string
+	if m.tab == TasksTab {
+		duplicate, err := repository.DuplicateTask(name)
+		if err != nil {
+			m.setStatus(fmt.Sprintf("Failed to duplicate task: %v", err), true)
+			return
+		}
+		copyName = duplicate.Name
+	} else {
+		duplicate, err := repository.DuplicateWorkspace(name)
+		if err != nil {
+			m.setStatus(fmt.Sprintf("Failed to duplicate workspace: %v", err), true)
+			return
+		}
+		copyName = duplicate.Name
+	}
+
+	if err := m.reload(); err != nil {
+		m.setStatus(err.Error(), true)
+		return
+	}
+	m.focus(copyName)
+	m.setStatus(fmt.Sprintf("Duplicated '%s' as '%s'", name, copyName), false)
+}
+
+// askDelete opens the confirmation modal for the selected item.
+func (m *Model) askDelete() {
+	name := m.selectedName()
+	if name == "" {
+		return
+	}
+
+	m.confirm = &deletion{tab: m.tab, name: name}
+	if m.tab == TasksTab {
+		m.confirm.workspaces = m.workspacesWithTask(name)
+	}
+}
+
+// deleteConfirmed deletes the item of the confirmation modal. Tasks are removed from their workspaces too.
+func (m *Model) deleteConfirmed() {
+	target := m.confirm
+	m.confirm = nil
+
+	var err error
+	if target.tab == TasksTab {
+		err = repository.DeleteTasks([]string{target.name}, true)
+	} else {
+		err = repository.DeleteWorkspace(target.name)
+	}
+	if err != nil {
+		m.setStatus(fmt.Sprintf("Failed to delete '%s': %v", target.name, err), true)
+		return
+	}
+
+	if err := m.reload(); err != nil {
+		m.setStatus(err.Error(), true)
+		return
+	}
+	m.setStatus(fmt.Sprintf("Deleted '%s'", target.name), false)
+}
+
+// workspacesWithTask returns the names of the loaded workspaces that contain the named task.
+func (m *Model) workspacesWithTask(taskName string) []string {
+	return lo.FilterMap(m.workspaces, func(ws models.Workspace, _ int) (string, bool) {
+		return ws.Name, lo.ContainsBy(ws.Tasks, func(task models.Task) bool {
+			return strings.EqualFold(task.Name, taskName)
+		})
+	})
+}
+
+// setStatus shows a message above the footer until the next one.
+func (m *Model) setStatus(message string, isError bool) {
+	m.status = message
+	m.statusIsError = isError
+}
+
+// reload reads the saved tasks and workspaces again and reapplies the filter.
+func (m *Model) reload() error {
+	tasks, err := repository.ReadTasks()
+	if err != nil {
+		return fmt.Errorf("failed to load tasks: %w", err)
+	}
+	workspaces, err := repository.ReadWorkspaces()
+	if err != nil {
+		return fmt.Errorf("failed to load workspaces: %w", err)
+	}
+
+	m.tasks = tasks
+	m.workspaces = workspaces
+	m.applyFilter()
+	return nil
+}
+
+// applyFilter recomputes the visible names of the current tab and keeps the cursor in range.
+func (m *Model) applyFilter() {
+	query := m.filter.Value()
+
+	if m.tab == TasksTab {
+		names := lo.Map(m.tasks, func(task models.Task, _ int) string { return task.Name })
+		m.visible = rankNames(names, query, func(i int) (int, bool) { return scoreTask(m.tasks[i], query) })
+	} else {
+		names := lo.Map(m.workspaces, func(ws models.Workspace, _ int) string { return ws.Name })
+		m.visible = rankNames(names, query, func(i int) (int, bool) { return scoreWorkspace(m.workspaces[i], query) })
+	}
+
+	if m.cursor >= len(m.visible) {
+		m.cursor = max(len(m.visible)-1, 0)
+	}
+}
+
+// selectedTask returns the task under the cursor on the tasks tab.
+func (m *Model) selectedTask() (models.Task, bool) {
+	return lo.Find(m.tasks, func(task models.Task) bool { return task.Name == m.selectedName() })
+}
+
+// selectedWorkspace returns the workspace under the cursor on the workspaces tab.
+func (m *Model) selectedWorkspace() (models.Workspace, bool) {
+	return lo.Find(m.workspaces, func(ws models.Workspace) bool { return ws.Name == m.selectedName() })
+}
@@ -0,0 +1,80 @@
+package dashboard
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/repository"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/tui/suggestions"
+	"github.com/samber/lo"
+)
+
+// scoreTask matches a task against every term of query. Terms starting with # must be tags of the task,
+// other terms fuzzy-match its name or appear in its description or path.
+func scoreTask(task models.Task, query string) (int, bool) {
+	total := 0
+	for _, term := range strings.Fields(query) {
+		if strings.HasPrefix(term, "#") {
+			if !repository.TaskMatchesQuery(task, term) {
+				return 0, false
+			}
+			continue
+		}
+
+		if score, _, ok := suggestions.FuzzyScore(task.Name, term); ok {
+			total += score
+			continue
+		}
+		if !repository.TaskMatchesQuery(task, term) {
+			return 0, false
+		}
+	}
+	return total, true
+}
+
+// scoreWorkspace matches a workspace against every term of query. Terms fuzzy-match the workspace name
+// or match one of its tasks the way scoreTask does.
+func scoreWorkspace(workspace models.Workspace, query string) (int, bool) {
+	total := 0
+	for _, term := range strings.Fields(query) {
+		if score, _, ok := suggestions.FuzzyScore(workspace.Name, term); ok && !strings.HasPrefix(term, "#") {
+			total += score
+			continue
+		}
+
+		if !lo.SomeBy(workspace.Tasks, func(task models.Task) bool {
+			_, ok := scoreTask(task, term)
+			return ok
+		}) {
+			return 0, false
+		}
+	}
+	return total, true
+}
+
+// rankNames keeps the names whose score function matches query, best score first.
+// Ties and an empty query keep the saved order.
+func rankNames(names []string, query string, score func(index int) (int, bool)) []string {
+	if strings.TrimSpace(query) == "" {
+		return names
+	}
+
+	type rankedName struct {
+		name  string
+		score int
+	}
+
+	var ranked []rankedName
+	for i, name := range names {
+		if s, ok := score(i); ok {
+			ranked = append(ranked, rankedName{name: name, score: s})
+		}
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].score > ranked[j].score
+	})
+
+	return lo.Map(ranked, func(r rankedName, _ int) string { return r.name })
+}
@@ -0,0 +1,41 @@
+package dashboard
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+)
+
+func TestRankNames(t *testing.T) {
+	tasks := []models.Task{
+		{Name: "web-app", Path: "/srv/web"},
+		{Name: "my-api", Path: "/srv/my-api"},
+		{Name: "api", Path: "/srv/api", Tags: []string{"backend"}},
+		{Name: "worker", Path: "/srv/worker", Description: "API jobs"},
+	}
+	names := []string{"web-app", "my-api", "api", "worker"}
+
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{name: "empty query keeps saved order", query: "", expected: names},
+		{name: "prefix ranks before word start", query: "api", expected: []string{"api", "my-api", "worker"}},
+		{name: "tag term filters", query: "#back", expected: []string{"api"}},
+		{name: "no match", query: "zzz", expected: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			result := rankNames(names, tt.query, func(i int) (int, bool) { return scoreTask(tasks[i], tt.query) })
+
+			// Assert
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("rankNames(%q) = %v, expected %v", tt.query, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package dashboard
+
+import (
+	"fmt"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/repository"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/task"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/vscode"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/workspace"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Run shows the dashboard opened on tab until the user quits or launches something.
+// Edit forms need the whole terminal, so the dashboard quits to show them and comes back afterwards.
+func Run(tab Tab) error {
+	focused := ""
+
+	for {
+		model, err := New(tab)
+		if err != nil {
+			return err
+		}
+		model.focus(focused)
+
+		finalModel, err := tea.NewProgram(model, tea.WithAltScreen()).Run()
+		if err != nil {
+			return fmt.Errorf("failed to run dashboard: %w", err)
+		}
+
+		action := finalModel.(*Model).action
+		switch action.kind {
+		case runAction:
+			return launch(action)
+		case editAction:
+			if err := edit(action); err != nil {
+				return err
+			}
+			tab, focused = action.tab, action.name
+		default:
+			return nil
+		}
+	}
+}
+
+// launch runs the chosen task or workspace in VSCode.
+func launch(action pendingAction) error {
+	runner, err := vscode.NewSecureRunner()
+	if err != nil {
+		return fmt.Errorf("failed to create secure runner: %w", err)
+	}
+
+	if action.tab == TasksTab {
+		return runner.RunTask(action.name)
+	}
+	return runner.RunWorkspace(action.name)
+}
+
+// edit opens the edit form of the chosen task or workspace.
+func edit(action pendingAction) error {
+	if action.tab == WorkspacesTab {
+		return workspace.EditWorkspaceCommand(action.name)
+	}
+
+	existing, err := repository.FindTaskByName(action.name)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tea.NewProgram(task.NewEditModel(existing)).Run(); err != nil {
+		return fmt.Errorf("failed to run task edit form: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+package dashboard
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/paths"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/repository"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/samber/lo"
+)
+
+// newSeededModel points the stores at a temporary directory, saves tasks and workspaces in it
+// and opens a dashboard on tab.
+func newSeededModel(t *testing.T, tab Tab, tasks []models.Task, workspaces []models.Workspace) *Model {
+	t.Helper()
+	t.Setenv(paths.ConfigDirEnv, t.TempDir())
+	t.Setenv(paths.ProfileEnv, "")
+
+	for _, task := range tasks {
+		if err := repository.SaveTask(task); err != nil {
+			t.Fatalf("failed to seed task %s: %v", task.Name, err)
+		}
+	}
+	for _, ws := range workspaces {
+		if err := repository.SaveWorkspace(ws); err != nil {
+			t.Fatalf("failed to seed workspace %s: %v", ws.Name, err)
+		}
+	}
+
+	m, err := New(tab)
+	if err != nil {
+		t.Fatalf("failed to open the dashboard: %v", err)
+	}
+	return m
+}
+
+// key builds the key message of a single key press, e.g. "y" or "esc".
+func key(name string) tea.KeyMsg {
+	if name == "esc" {
+		return tea.KeyMsg{Type: tea.KeyEsc}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(name)}
+}
+
+// savedNames returns the names of the saved tasks and workspaces.
+func savedNames(t *testing.T) ([]string, []string) {
+	t.Helper()
+	tasks, err := repository.ReadTasks()
+	if err != nil {
+		t.Fatalf("failed to read tasks: %v", err)
+	}
+	workspaces, err := repository.ReadWorkspaces()
+	if err != nil {
+		t.Fatalf("failed to read workspaces: %v", err)
+	}
+	return lo.Map(tasks, func(task models.Task, _ int) string { return task.Name }),
+		lo.Map(workspaces, func(ws models.Workspace, _ int) string { return ws.Name })
+}
+
+func TestHandleConfirmKey(t *testing.T) {
+	api := models.Task{Name: "api", Path: "/srv/api"}
+	web := models.Task{Name: "web", Path: "/srv/web"}
+
+	tests := []struct {
+		name               string
+		tab                Tab
+		answer             string
+		expectedTasks      []string
+		expectedWorkspaces []string
+		expectedDevTasks   []string
+	}{
+		{
+			name:               "y deletes the task and removes it from its workspaces",
+			tab:                TasksTab,
+			answer:             "y",
+			expectedTasks:      []string{"web"},
+			expectedWorkspaces: []string{"dev", "front"},
+			expectedDevTasks:   []string{"web"},
+		},
+		{
+			name:               "Y deletes like y",
+			tab:                TasksTab,
+			answer:             "Y",
+			expectedTasks:      []string{"web"},
+			expectedWorkspaces: []string{"dev", "front"},
+			expectedDevTasks:   []string{"web"},
+		},
+		{
+			name:               "n keeps the task",
+			tab:                TasksTab,
+			answer:             "n",
+			expectedTasks:      []string{"api", "web"},
+			expectedWorkspaces: []string{"dev", "front"},
+			expectedDevTasks:   []string{"api", "web"},
+		},
+		{
+			name:               "esc keeps the task",
+			tab:                TasksTab,
+			answer:             "esc",
+			expectedTasks:      []string{"api", "web"},
+			expectedWorkspaces: []string{"dev", "front"},
+			expectedDevTasks:   []string{"api", "web"},
+		},
+		{
+			name:               "y deletes the workspace and keeps its tasks",
+			tab:                WorkspacesTab,
+			answer:             "y",
+			expectedTasks:      []string{"api", "web"},
+			expectedWorkspaces: []string{"front"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			m := newSeededModel(t, tt.tab, []models.Task{api, web}, []models.Workspace{
+				{Name: "dev", Tasks: []models.Task{api, web}},
+				{Name: "front", Tasks: []models.Task{web}},
+			})
+			m.Update(key("d"))
+			if m.confirm == nil {
+				t.Fatal("expected the delete modal to open")
+			}
+			if tt.tab == TasksTab && !reflect.DeepEqual(m.confirm.workspaces, []string{"dev"}) {
+				t.Errorf("expected the modal to list workspace dev, got %v", m.confirm.workspaces)
+			}
+
+			// Act
+			m.Update(key(tt.answer))
+
+			// Assert
+			if m.confirm != nil {
+				t.Error("expected the delete modal to close")
+			}
+			tasks, workspaces := savedNames(t)
+			if !reflect.DeepEqual(tasks, tt.expectedTasks) {
+				t.Errorf("expected tasks %v, got %v", tt.expectedTasks, tasks)
+			}
+			if !reflect.DeepEqual(workspaces, tt.expectedWorkspaces) {
+				t.Errorf("expected workspaces %v, got %v", tt.expectedWorkspaces, workspaces)
+			}
+			if tt.expectedDevTasks != nil {
+				dev, err := repository.FindWorkspaceByName("dev")
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				devTasks := lo.Map(dev.Tasks, func(task models.Task, _ int) string { return task.Name })
+				if !reflect.DeepEqual(devTasks, tt.expectedDevTasks) {
+					t.Errorf("expected dev tasks %v, got %v", tt.expectedDevTasks, devTasks)
+				}
+			}
+			if m.statusIsError {
+				t.Errorf("unexpected error status: %s", m.status)
+			}
+		})
+	}
+}
+
+func TestDuplicateSelected(t *testing.T) {
+	tests := []struct {
+		name             string
+		tab              Tab
+		tasks            []models.Task
+		workspaces       []models.Workspace
+		expectedSelected string
+		expectedNames    []string
+	}{
+		{
+			name:             "task copy gets the first free suffix and is selected",
+			tab:              TasksTab,
+			tasks:            []models.Task{{Name: "api", Path: "/srv/api"}, {Name: "api-2", Path: "/srv/api"}},
+			expectedSelected: "api-3",
+			expectedNames:    []string{"api", "api-2", "api-3"},
+		},
+		{
+			name:             "workspace copy gets the first free suffix and is selected",
+			tab:              WorkspacesTab,
+			workspaces:       []models.Workspace{{Name: "dev"}, {Name: "front"}},
+			expectedSelected: "dev-2",
+			expectedNames:    []string{"dev", "front", "dev-2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			m := newSeededModel(t, tt.tab, tt.tasks, tt.workspaces)
+
+			// Act
+			m.Update(key("c"))
+
+			// Assert
+			if m.statusIsError {
+				t.Fatalf("unexpected error status: %s", m.status)
+			}
+			if selected := m.selectedName(); selected != tt.expectedSelected {
+				t.Errorf("expected %q to be selected, got %q", tt.expectedSelected, selected)
+			}
+			tasks, workspaces := savedNames(t)
+			names := tasks
+			if tt.tab == WorkspacesTab {
+				names = workspaces
+			}
+			if !reflect.DeepEqual(names, tt.expectedNames) {
+				t.Errorf("expected saved names %v, got %v", tt.expectedNames, names)
+			}
+		})
+	}
+}
+
+func TestApplyFilter_ClampsCursor(t *testing.T) {
+	tasks := []models.Task{
+		{Name: "api", Path: "/srv/api"},
+		{Name: "web", Path: "/srv/web"},
+		{Name: "worker", Path: "/srv/worker"},
+	}
+
+	tests := []struct {
+		name             string
+		cursor           int
+		query            string
+		expectedCursor   int
+		expectedSelected string
+	}{
+		{name: "cursor inside the results is kept", cursor: 1, query: "w", expectedCursor: 1, expectedSelected: "worker"},
+		{name: "cursor past the results moves to the last one", cursor: 2, query: "api", expectedCursor: 0, expectedSelected: "api"},
+		{name: "no results resets the cursor", cursor: 2, query: "zzz", expectedCursor: 0, expectedSelected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			m := newSeededModel(t, TasksTab, tasks, nil)
+			m.cursor = tt.cursor
+			m.filter.SetValue(tt.query)
+
+			// Act
+			m.applyFilter()
+
+			// Assert
+			if m.cursor != tt.expectedCursor {
+				t.Errorf("expected cursor %d, got %d", tt.expectedCursor, m.cursor)
+			}
+			if selected := m.selectedName(); selected != tt.expectedSelected {
+				t.Errorf("expected %q to be selected, got %q", tt.expectedSelected, selected)
+			}
+		})
+	}
+}
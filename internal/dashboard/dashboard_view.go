@@ -0,0 +1,227 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/samber/lo"
+)
+
+// Layout constants, matching the pane styles
+const (
+	maxVisibleItems = 12 // List rows shown at once, the rest scrolls
+	listNameWidth   = 34 // Characters of a name shown in the list
+)
+
+// View renders the tabs, the list with its detail pane, and the footer.
+// The delete confirmation replaces the panes while it is open.
+func (m *Model) View() string {
+	var sections []string
+
+	sections = append(sections, m.renderTabs())
+
+	if m.filtering || m.filter.Value() != "" {
+		sections = append(sections, m.filter.View())
+	}
+
+	if m.confirm != nil {
+		sections = append(sections, m.renderConfirm())
+	} else {
+		sections = append(sections, lipgloss.JoinHorizontal(lipgloss.Top,
+			styles.ListPaneStyle.Render(m.renderList()),
+			styles.DetailPaneStyle.Render(m.renderDetail()),
+		))
+	}
+
+	if m.status != "" {
+		sections = append(sections, m.renderStatus())
+	}
+
+	sections = append(sections, m.renderBridgeStatus(), m.renderHelpText())
+
+	return strings.Join(sections, "\n") + "\n"
+}
+
+// renderTabs renders the tab bar with the number of items of each tab.
+func (m *Model) renderTabs() string {
+	labels := []string{
+		fmt.Sprintf("1 Tasks (%d)", len(m.tasks)),
+		fmt.Sprintf("2 Workspaces (%d)", len(m.workspaces)),
+	}
+
+	tabs := lo.Map(labels, func(label string, i int) string {
+		if Tab(i) == m.tab {
+			return styles.ActiveTabStyle.Render(label)
+		}
+		return styles.InactiveTabStyle.Render(label)
+	})
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, tabs...) + "\n"
+}
+
+// renderList renders the visible names, scrolling to keep the cursor in view.
+func (m *Model) renderList() string {
+	if len(m.visible) == 0 {
+		return styles.LightGrayStyle.Render(m.emptyListText())
+	}
+
+	start := 0
+	if m.cursor >= maxVisibleItems {
+		start = m.cursor - maxVisibleItems + 1
+	}
+	end := min(start+maxVisibleItems, len(m.visible))
+
+	rows := make([]string, 0, end-start)
+	for i := start; i < end; i++ {
+		name := truncate(m.visible[i], listNameWidth)
+		if i == m.cursor {
+			rows = append(rows, styles.FocusedTaskStyle.Render("▶ "+name))
+		} else {
+			rows = append(rows, "  "+name)
+		}
+	}
+
+	if len(m.visible) > maxVisibleItems {
+		rows = append(rows, styles.LightGrayStyle.Render(fmt.Sprintf("  %d/%d", m.cursor+1, len(m.visible))))
+	}
+
+	return strings.Join(rows, "\n")
+}
+
+// emptyListText explains why the current tab shows nothing.
+func (m *Model) emptyListText() string {
+	if m.filter.Value() != "" {
+		return "Nothing matches the filter."
+	}
+	if m.tab == TasksTab {
+		return "No tasks yet.\nCreate one with 'vstr task create'."
+	}
+	return "No workspaces yet.\nCreate one with\n'vstr workspace create'."
+}
+
+// renderDetail renders the selected task or workspace.
+func (m *Model) renderDetail() string {
+	if m.tab == TasksTab {
+		if task, ok := m.selectedTask(); ok {
+			return m.renderTaskDetail(task)
+		}
+	} else if workspace, ok := m.selectedWorkspace(); ok {
+		return renderWorkspaceDetail(workspace)
+	}
+	return ""
+}
+
+// renderTaskDetail renders every field of a task and the workspaces using it.
+func (m *Model) renderTaskDetail(task models.Task) string {
+	lines := []string{styles.RunnerTaskNameStyle.Render(task.Name)}
+	if task.Description != "" {
+		lines = append(lines, styles.LightGrayStyle.Render(task.Description))
+	}
+	lines = append(lines, "",
+		detailLine("Path", task.Path),
+		detailLine("Icon", fmt.Sprintf("%s (%s)", task.Icon, task.IconColor)),
+	)
+	if len(task.Tags) > 0 {
+		lines = append(lines, detailLine("Tags", "#"+strings.Join(task.Tags, " #")))
+	}
+	if workspaces := m.workspacesWithTask(task.Name); len(workspaces) > 0 {
+		lines = append(lines, detailLine("Used by", strings.Join(workspaces, ", ")))
+	}
+
+	lines = append(lines, "", styles.FieldLabelStyle.Render("Commands"))
+	for i, cmd := range task.Cmds {
+		lines = append(lines, fmt.Sprintf("%2d. %s", i+1, cmd))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// renderWorkspaceDetail renders the tasks of a workspace in launch order.
+func renderWorkspaceDetail(workspace models.Workspace) string {
+	lines := []string{
+		styles.RunnerTaskNameStyle.Render(workspace.Name),
+		styles.LightGrayStyle.Render(fmt.Sprintf("%d task(s), in launch order", len(workspace.Tasks))),
+		"",
+	}
+
+	for i, task := range workspace.Tasks {
+		lines = append(lines,
+			fmt.Sprintf("%2d. %s", i+1, task.Name),
+			styles.LightGrayStyle.Render("    📁 "+task.Path),
+		)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// detailLine renders a labelled value of the detail pane.
+func detailLine(label, value string) string {
+	return styles.FieldLabelStyle.Render(fmt.Sprintf("%-8s", label)) + " " + value
+}
+
+// renderConfirm renders the delete confirmation modal.
+func (m *Model) renderConfirm() string {
+	kind := "task"
+	if m.confirm.tab == WorkspacesTab {
+		kind = "workspace"
+	}
+
+	lines := []string{
+		styles.RunnerErrorStyle.Render(fmt.Sprintf("Delete %s '%s'?", kind, m.confirm.name)),
+	}
+	if len(m.confirm.workspaces) > 0 {
+		lines = append(lines, "",
+			"It is also removed from these workspaces:",
+			styles.LightGrayStyle.Render("  "+strings.Join(m.confirm.workspaces, ", ")),
+		)
+	}
+	lines = append(lines, "", lipgloss.JoinHorizontal(lipgloss.Center,
+		styles.RenderDangerButton("y delete"), "  ", styles.RenderBlurredButton("n cancel"),
+	))
+
+	return styles.ModalStyle.Render(strings.Join(lines, "\n"))
+}
+
+// renderStatus renders the result of the last duplicate or delete.
+func (m *Model) renderStatus() string {
+	if m.statusIsError {
+		return styles.RunnerErrorStyle.Render(styles.ErrorIcon + " " + m.status)
+	}
+	return styles.RunnerSuccessStyle.Render(styles.SuccessIcon + " " + m.status)
+}
+
+// renderBridgeStatus renders the footer line with the bridge tasks would be launched through.
+func (m *Model) renderBridgeStatus() string {
+	switch {
+	case !m.bridgeChecked:
+		return styles.LightGrayStyle.Render("● Bridge: checking...")
+	case m.bridge == nil:
+		return styles.BridgeDisconnectedStyle.Render("● Bridge: not found, open VSCode with the VSTR-Bridge extension")
+	default:
+		return styles.BridgeConnectedStyle.Render(fmt.Sprintf("● Bridge: %s on port %d", m.bridge.WorkspaceName, m.bridge.Port))
+	}
+}
+
+// renderHelpText renders the hotkeys of the current mode.
+func (m *Model) renderHelpText() string {
+	switch {
+	case m.confirm != nil:
+		return styles.LightGrayStyle.Render("y delete • n/esc cancel")
+	case m.filtering:
+		return styles.LightGrayStyle.Render("↑/↓ navigate • enter apply filter • esc clear filter")
+	default:
+		return styles.LightGrayStyle.Render("↑/↓ navigate • tab switch • / filter • enter run • e edit • c duplicate • d delete • q quit")
+	}
+}
+
+// truncate shortens text to width characters, adding an ellipsis when needed.
+func truncate(text string, width int) string {
+	runes := []rune(text)
+	if len(runes) <= width {
+		return text
+	}
+	return string(runes[:width-3]) + "..."
+}
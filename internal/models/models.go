@@ -2,20 +2,35 @@ package models
 
 // Task represents an individual task that can be executed in a VSCode terminal.
 type Task struct {
-	Name      string   `json:"name"`      // Task name
-	Path      string   `json:"path"`      // Associated project path
-	Cmds      []string `json:"cmds"`      // Commands to execute
-	Icon      string   `json:"icon"`      // VSCode terminal icon
-	IconColor string   `json:"iconColor"` // Icon color in the terminal
+	Name        string   `json:"name"`                  // Task name
+	Description string   `json:"description,omitempty"` // Short explanation shown in lists and pickers
+	Path        string   `json:"path"`                  // Associated project path
+	Cmds        []string `json:"cmds"`                  // Commands to execute
+	Icon        string   `json:"icon"`                  // VSCode terminal icon
+	IconColor   string   `json:"iconColor"`             // Icon color in the terminal
+	Tags        []string `json:"tags,omitempty"`        // Labels used to select tasks, e.g. "backend"
 }
 
 // Workspace represents a workspace containing multiple tasks.
+// The order of Tasks is the launch order: terminals are opened in that sequence.
 type Workspace struct {
 	Name  string `json:"name"`
-	Tasks []Task `json:"tasks"`
+	Tasks []Task `json:"tasks"` // Task copies, in launch order
 }
 
 // Config represents the configuration for the terminal runner.
+// See docs/CONFIGURATION.md for the documented schema and defaults.
 type Config struct {
-	IsSetupComplete bool `json:"is_setup_complete"`
-}
\ No newline at end of file
+	IsSetupComplete   bool     `json:"is_setup_complete"`
+	EditorCLI         string   `json:"editor_cli"`         // Editor CLI used to manage the extension (code, codium, cursor...)
+	BridgeDir         string   `json:"bridge_dir"`         // Overrides the platform bridge directory when set
+	ConnectTimeout    int      `json:"connect_timeout"`    // Seconds allowed to handshake with the bridge
+	TaskTimeout       int      `json:"task_timeout"`       // Seconds allowed to launch a single task
+	WorkspaceTimeout  int      `json:"workspace_timeout"`  // Seconds allowed to launch a whole workspace
+	DefaultIcon       string   `json:"default_icon"`       // Icon pre-filled when creating tasks
+	DefaultIconColor  string   `json:"default_icon_color"` // Icon color pre-filled when creating tasks
+	SuggestionHistory bool     `json:"suggestion_history"` // Rank suggestions by the values saved in previous tasks
+	PathSearchRoots   []string `json:"path_search_roots"`  // Directories searched for git repositories to suggest as task paths
+	PathBookmarks     []string `json:"path_bookmarks"`     // Paths always suggested in the task path field
+	PathSuggestFiles  bool     `json:"path_suggest_files"` // Also suggest files in the task path field
+}
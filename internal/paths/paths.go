@@ -0,0 +1,199 @@
+// Package paths resolves where VSTR stores its tasks, workspaces and configuration.
+//
+// Resolution order for the configuration directory:
+//  1. The --config-dir flag (see SetConfigDir)
+//  2. The VSTR_CONFIG_DIR environment variable
+//  3. $XDG_CONFIG_HOME/vscode-terminal-runner
+//  4. os.UserConfigDir()/vscode-terminal-runner
+//
+// Tasks, workspaces and configuration belong to the active profile. The "default" profile
+// lives directly in the configuration directory; every other profile lives in
+// <config dir>/profiles/<name>. The active profile is resolved from the --profile flag
+// (see SetProfile), the VSTR_PROFILE environment variable, the profile saved by
+// 'vstr profile use', and finally "default".
+//
+// Nothing is created on disk by this package; stores create their files lazily on first write.
+package paths
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// AppDirName is the directory name used inside the XDG and platform base directories.
+	AppDirName = "vscode-terminal-runner"
+
+	// ConfigDirEnv is the environment variable that overrides the configuration directory.
+	ConfigDirEnv = "VSTR_CONFIG_DIR"
+
+	// ProfileEnv is the environment variable that selects the active profile.
+	ProfileEnv = "VSTR_PROFILE"
+
+	// DefaultProfile is the profile stored directly in the configuration directory.
+	DefaultProfile = "default"
+
+	tasksFileName         = "tasks.json"
+	workspacesFileName    = "workspaces.json"
+	configFileName        = "config.json"
+	profilesDirName       = "profiles"
+	activeProfileFileName = "active_profile"
+	historyFileName       = "history.json"
+)
+
+var (
+	// configDirOverride holds the value of the --config-dir flag.
+	configDirOverride string
+
+	// profileOverride holds the value of the --profile flag.
+	profileOverride string
+)
+
+// SetConfigDir overrides the configuration directory for the rest of the process.
+// An empty dir restores the default resolution order.
+func SetConfigDir(dir string) {
+	configDirOverride = dir
+}
+
+// ConfigDir returns the root directory holding every VSTR store.
+func ConfigDir() (string, error) {
+	if dir, ok := overriddenDir(); ok {
+		return filepath.Abs(dir)
+	}
+
+	if xdg := os.Getenv("XDG_CONFIG_HOME"); filepath.IsAbs(xdg) {
+		return filepath.Join(xdg, AppDirName), nil
+	}
+
+	cfgDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("could not determine user config directory: %w", err)
+	}
+	return filepath.Join(cfgDir, AppDirName), nil
+}
+
+// SetProfile selects the active profile for the rest of the process.
+// An empty name restores the default resolution order.
+func SetProfile(name string) {
+	profileOverride = name
+}
+
+// ActiveProfile returns the name of the profile whose stores are used.
+func ActiveProfile() (string, error) {
+	if profileOverride != "" {
+		return profileOverride, nil
+	}
+	if name := os.Getenv(ProfileEnv); name != "" {
+		return name, nil
+	}
+	return SavedProfile()
+}
+
+// SavedProfile returns the profile saved by 'vstr profile use', ignoring the --profile flag
+// and the VSTR_PROFILE environment variable.
+func SavedProfile() (string, error) {
+	activeFile, err := ActiveProfileFile()
+	if err != nil {
+		return "", err
+	}
+
+	content, err := os.ReadFile(activeFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return DefaultProfile, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if name := strings.TrimSpace(string(content)); name != "" {
+		return name, nil
+	}
+	return DefaultProfile, nil
+}
+
+// ActiveProfileFile returns the file where 'vstr profile use' saves the selected profile.
+func ActiveProfileFile() (string, error) {
+	return inConfigDir(activeProfileFileName)
+}
+
+// HistoryFile returns the file recording the paths, icons, colors and commands saved in tasks.
+// It is shared by every profile.
+func HistoryFile() (string, error) {
+	return inConfigDir(historyFileName)
+}
+
+// ProfilesDir returns the directory holding every profile except the default one.
+func ProfilesDir() (string, error) {
+	return inConfigDir(profilesDirName)
+}
+
+// ProfileDir returns the directory holding the stores of the named profile.
+func ProfileDir(name string) (string, error) {
+	if name == DefaultProfile {
+		return ConfigDir()
+	}
+
+	profilesDir, err := ProfilesDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(profilesDir, name), nil
+}
+
+// TasksFile returns the path of the tasks store of the active profile.
+func TasksFile() (string, error) {
+	return inActiveProfile(tasksFileName)
+}
+
+// WorkspacesFile returns the path of the workspaces store of the active profile.
+func WorkspacesFile() (string, error) {
+	return inActiveProfile(workspacesFileName)
+}
+
+// ConfigFile returns the path of the configuration file of the active profile.
+func ConfigFile() (string, error) {
+	return inActiveProfile(configFileName)
+}
+
+// ProfileStoreFiles returns the names of the files that make up a profile.
+func ProfileStoreFiles() []string {
+	return []string{tasksFileName, workspacesFileName, configFileName}
+}
+
+// overriddenDir returns the directory set by the flag or environment variable, if any.
+func overriddenDir() (string, bool) {
+	if configDirOverride != "" {
+		return configDirOverride, true
+	}
+	if dir := os.Getenv(ConfigDirEnv); dir != "" {
+		return dir, true
+	}
+	return "", false
+}
+
+// inConfigDir joins name to the configuration directory.
+func inConfigDir(name string) (string, error) {
+	dir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name), nil
+}
+
+// inActiveProfile joins name to the directory of the active profile.
+func inActiveProfile(name string) (string, error) {
+	profile, err := ActiveProfile()
+	if err != nil {
+		return "", err
+	}
+
+	dir, err := ProfileDir(profile)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name), nil
+}
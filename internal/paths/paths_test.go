@@ -0,0 +1,151 @@
+package paths
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestConfigDir(t *testing.T) {
+	tests := []struct {
+		name     string
+		flag     string
+		env      string
+		xdg      string
+		expected string
+	}{
+		{
+			name:     "flag takes precedence over everything",
+			flag:     "/from/flag",
+			env:      "/from/env",
+			xdg:      "/xdg",
+			expected: "/from/flag",
+		},
+		{
+			name:     "environment variable overrides XDG",
+			env:      "/from/env",
+			xdg:      "/xdg",
+			expected: "/from/env",
+		},
+		{
+			name:     "XDG_CONFIG_HOME is used when no override is set",
+			xdg:      "/xdg",
+			expected: filepath.Join("/xdg", AppDirName),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			t.Setenv(ConfigDirEnv, tt.env)
+			t.Setenv("XDG_CONFIG_HOME", tt.xdg)
+			SetConfigDir(tt.flag)
+			defer SetConfigDir("")
+
+			// Act
+			dir, err := ConfigDir()
+
+			// Assert
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if dir != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, dir)
+			}
+		})
+	}
+}
+
+func TestStoresFollowOverride(t *testing.T) {
+	// Arrange
+	override := t.TempDir()
+	t.Setenv(ConfigDirEnv, override)
+
+	resolvers := map[string]func() (string, error){
+		"tasks.json":      TasksFile,
+		"workspaces.json": WorkspacesFile,
+		"config.json":     ConfigFile,
+	}
+
+	for name, resolve := range resolvers {
+		// Act
+		path, err := resolve()
+
+		// Assert
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if expected := filepath.Join(override, name); path != expected {
+			t.Errorf("%s: expected %q, got %q", name, expected, path)
+		}
+	}
+}
+
+func TestActiveProfile(t *testing.T) {
+	tests := []struct {
+		name        string
+		flag        string
+		env         string
+		saved       string
+		expected    string
+		expectedDir func(configDir string) string
+	}{
+		{
+			name:        "falls back to the default profile",
+			expected:    DefaultProfile,
+			expectedDir: func(configDir string) string { return configDir },
+		},
+		{
+			name:        "uses the profile saved by 'profile use'",
+			saved:       "work\n",
+			expected:    "work",
+			expectedDir: func(configDir string) string { return filepath.Join(configDir, "profiles", "work") },
+		},
+		{
+			name:        "environment variable overrides the saved profile",
+			env:         "personal",
+			saved:       "work",
+			expected:    "personal",
+			expectedDir: func(configDir string) string { return filepath.Join(configDir, "profiles", "personal") },
+		},
+		{
+			name:        "flag overrides the environment variable",
+			flag:        "demo",
+			env:         "personal",
+			expected:    "demo",
+			expectedDir: func(configDir string) string { return filepath.Join(configDir, "profiles", "demo") },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			configDir := t.TempDir()
+			t.Setenv(ConfigDirEnv, configDir)
+			t.Setenv(ProfileEnv, tt.env)
+			SetProfile(tt.flag)
+			defer SetProfile("")
+
+			if tt.saved != "" {
+				if err := os.WriteFile(filepath.Join(configDir, activeProfileFileName), []byte(tt.saved), 0644); err != nil {
+					t.Fatalf("failed to save active profile: %v", err)
+				}
+			}
+
+			// Act
+			profile, err := ActiveProfile()
+			tasksFile, tasksErr := TasksFile()
+
+			// Assert
+			if err != nil || tasksErr != nil {
+				t.Fatalf("unexpected error: %v, %v", err, tasksErr)
+			}
+			if profile != tt.expected {
+				t.Errorf("expected profile %q, got %q", tt.expected, profile)
+			}
+			if expected := filepath.Join(tt.expectedDir(configDir), tasksFileName); tasksFile != expected {
+				t.Errorf("expected tasks file %q, got %q", expected, tasksFile)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package profile
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/paths"
+)
+
+var (
+	ErrProfileExists   = errors.New("profile already exists")
+	ErrProfileNotFound = errors.New("profile not found")
+	ErrInvalidName     = errors.New("invalid profile name")
+	ErrDefaultProfile  = errors.New("the default profile cannot be deleted")
+)
+
+// validName restricts profile names to characters that are safe as directory names.
+var validName = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*$`)
+
+// List returns every profile name, starting with the default profile.
+func List() ([]string, error) {
+	profiles := []string{paths.DefaultProfile}
+
+	profilesDir, err := paths.ProfilesDir()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(profilesDir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return profiles, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() && validName.MatchString(entry.Name()) && entry.Name() != paths.DefaultProfile {
+			profiles = append(profiles, entry.Name())
+		}
+	}
+
+	slices.Sort(profiles[1:])
+	return profiles, nil
+}
+
+// Exists reports whether the named profile exists.
+func Exists(name string) (bool, error) {
+	profiles, err := List()
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(profiles, name), nil
+}
+
+// EnsureExists returns ErrProfileNotFound when the named profile does not exist.
+func EnsureExists(name string) error {
+	exists, err := Exists(name)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("%w: '%s' (create it with 'vstr profile create %s')", ErrProfileNotFound, name, name)
+	}
+	return nil
+}
+
+// Create creates an empty profile. The configuration of the active profile is copied
+// so the editor choice and setup state carry over.
+func Create(name string) error {
+	if err := ensureAvailable(name); err != nil {
+		return err
+	}
+
+	dir, err := paths.ProfileDir(name)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	configFile, err := paths.ConfigFile()
+	if err != nil {
+		return err
+	}
+	return copyFile(configFile, filepath.Join(dir, filepath.Base(configFile)))
+}
+
+// Copy creates a new profile holding a copy of every store of an existing profile.
+func Copy(source, target string) error {
+	if err := EnsureExists(source); err != nil {
+		return err
+	}
+	if err := ensureAvailable(target); err != nil {
+		return err
+	}
+
+	sourceDir, err := paths.ProfileDir(source)
+	if err != nil {
+		return err
+	}
+	targetDir, err := paths.ProfileDir(target)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(targetDir, 0755); err != nil {
+		return err
+	}
+
+	for _, name := range paths.ProfileStoreFiles() {
+		if err := copyFile(filepath.Join(sourceDir, name), filepath.Join(targetDir, name)); err != nil {
+			return fmt.Errorf("failed to copy %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Use saves the named profile as the active one for future invocations.
+func Use(name string) error {
+	if err := EnsureExists(name); err != nil {
+		return err
+	}
+
+	activeFile, err := paths.ActiveProfileFile()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(activeFile), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(activeFile, []byte(name+"\n"), 0644)
+}
+
+// Delete removes the named profile and all of its stores.
+// Deleting the profile saved by Use switches back to the default one.
+func Delete(name string) error {
+	if name == paths.DefaultProfile {
+		return ErrDefaultProfile
+	}
+	if err := EnsureExists(name); err != nil {
+		return err
+	}
+
+	dir, err := paths.ProfileDir(name)
+	if err != nil {
+		return err
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+
+	// --profile and VSTR_PROFILE only apply to this invocation, the saved profile may be another one
+	if saved, err := paths.SavedProfile(); err == nil && saved == name {
+		return Use(paths.DefaultProfile)
+	}
+	return nil
+}
+
+// ensureAvailable checks that name is valid and not used by another profile.
+func ensureAvailable(name string) error {
+	if !validName.MatchString(name) {
+		return fmt.Errorf("%w: '%s' (use letters, digits, '-' and '_')", ErrInvalidName, name)
+	}
+
+	exists, err := Exists(name)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return fmt.Errorf("%w: '%s'", ErrProfileExists, name)
+	}
+	return nil
+}
+
+// copyFile copies source to target. A missing source is not an error since stores are created lazily.
+func copyFile(source, target string) error {
+	content, err := os.ReadFile(source)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(target, content, 0644)
+}
@@ -0,0 +1,119 @@
+package profile
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/paths"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/prompt"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
+	"github.com/spf13/cobra"
+)
+
+// ListCmd lists every profile, marking the active one.
+var ListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all profiles",
+	Long:  `List every profile. The active profile is marked with '*'.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		profiles, err := List()
+		if err != nil {
+			return err
+		}
+
+		active, err := paths.ActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		for _, name := range profiles {
+			marker := "  "
+			if name == active {
+				marker = "* "
+			}
+			fmt.Println(marker + name)
+		}
+		return nil
+	},
+}
+
+// CreateCmd creates a new empty profile.
+var CreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Create a new profile",
+	Long:  `Create a new profile with its own tasks and workspaces. The configuration of the active profile is copied.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := Create(args[0]); err != nil {
+			styles.PrintError(fmt.Sprintf("Failed to create profile: %v", err))
+			os.Exit(1)
+		}
+		styles.PrintSuccess(fmt.Sprintf("Profile '%s' created. Switch to it with 'vstr profile use %s'.", args[0], args[0]))
+	},
+}
+
+// UseCmd saves a profile as the active one.
+var UseCmd = &cobra.Command{
+	Use:   "use <name>",
+	Short: "Switch the active profile",
+	Long:  `Save a profile as the active one. The --profile flag and VSTR_PROFILE still take precedence.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := Use(args[0]); err != nil {
+			styles.PrintError(fmt.Sprintf("Failed to switch profile: %v", err))
+			os.Exit(1)
+		}
+		styles.PrintSuccess(fmt.Sprintf("Now using profile '%s'", args[0]))
+	},
+}
+
+// DeleteCmd deletes a profile after confirmation.
+var DeleteCmd = &cobra.Command{
+	Use:   "delete <name>",
+	Short: "Delete a profile",
+	Long:  `Delete a profile with all of its tasks, workspaces and configuration`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := args[0]
+		skipConfirmation, _ := cmd.Flags().GetBool("yes")
+
+		if name == paths.DefaultProfile {
+			styles.PrintError(ErrDefaultProfile.Error())
+			os.Exit(1)
+		}
+		if err := EnsureExists(name); err != nil {
+			styles.PrintError(err.Error())
+			os.Exit(1)
+		}
+
+		if !skipConfirmation && !prompt.Confirm(fmt.Sprintf("Delete profile '%s' with all of its tasks and workspaces?", name)) {
+			styles.PrintInfo("Aborted.")
+			return
+		}
+
+		if err := Delete(name); err != nil {
+			styles.PrintError(fmt.Sprintf("Failed to delete profile: %v", err))
+			os.Exit(1)
+		}
+		styles.PrintSuccess(fmt.Sprintf("Profile '%s' deleted", name))
+	},
+}
+
+// CopyCmd duplicates a profile under a new name.
+var CopyCmd = &cobra.Command{
+	Use:   "copy <source> <target>",
+	Short: "Copy a profile",
+	Long:  `Create a new profile holding a copy of the tasks, workspaces and configuration of an existing one`,
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := Copy(args[0], args[1]); err != nil {
+			styles.PrintError(fmt.Sprintf("Failed to copy profile: %v", err))
+			os.Exit(1)
+		}
+		styles.PrintSuccess(fmt.Sprintf("Profile '%s' copied to '%s'", args[0], args[1]))
+	},
+}
+
+func init() {
+	DeleteCmd.Flags().BoolP("yes", "y", false, "Delete without asking for confirmation")
+}
@@ -0,0 +1,69 @@
+package profile
+
+import (
+	"testing"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/paths"
+)
+
+func TestDelete_SavedProfile(t *testing.T) {
+	tests := []struct {
+		name          string
+		saved         string
+		envProfile    string
+		deleted       string
+		expectedSaved string
+	}{
+		{
+			name:          "deleting the saved profile switches back to default",
+			saved:         "work",
+			deleted:       "work",
+			expectedSaved: paths.DefaultProfile,
+		},
+		{
+			name:          "deleting a profile selected by VSTR_PROFILE keeps the saved one",
+			saved:         "home",
+			envProfile:    "work",
+			deleted:       "work",
+			expectedSaved: "home",
+		},
+		{
+			name:          "deleting another profile keeps the saved one",
+			saved:         "home",
+			deleted:       "work",
+			expectedSaved: "home",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			t.Setenv(paths.ConfigDirEnv, t.TempDir())
+			t.Setenv(paths.ProfileEnv, "")
+			for _, name := range []string{"home", "work"} {
+				if err := Create(name); err != nil {
+					t.Fatalf("failed to create profile %s: %v", name, err)
+				}
+			}
+			if err := Use(tt.saved); err != nil {
+				t.Fatalf("failed to use profile %s: %v", tt.saved, err)
+			}
+			t.Setenv(paths.ProfileEnv, tt.envProfile)
+
+			// Act
+			err := Delete(tt.deleted)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			saved, err := paths.SavedProfile()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if saved != tt.expectedSaved {
+				t.Errorf("expected saved profile %q, got %q", tt.expectedSaved, saved)
+			}
+		})
+	}
+}
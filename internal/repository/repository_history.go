@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/paths"
+	"github.com/samber/lo"
+)
+
+// HistoryKind groups the values recorded in the usage history.
+type HistoryKind string
+
+const (
+	HistoryPaths    HistoryKind = "paths"
+	HistoryIcons    HistoryKind = "icons"
+	HistoryColors   HistoryKind = "colors"
+	HistoryCommands HistoryKind = "commands"
+)
+
+// maxHistoryEntries is the number of values kept per kind, the least frecent are dropped first.
+const maxHistoryEntries = 200
+
+// maxFrecency caps the boost of a single value, so history reorders close matches
+// without hiding a much better one.
+const maxFrecency = 48
+
+// HistoryEntry counts how often a value was saved and when it was last saved.
+type HistoryEntry struct {
+	Count    int       `json:"count"`
+	LastUsed time.Time `json:"last_used"`
+}
+
+// UsageHistory holds the values saved in tasks, by kind.
+type UsageHistory struct {
+	Entries map[HistoryKind]map[string]HistoryEntry `json:"entries"`
+}
+
+// LoadHistory reads the usage history, returning an empty one when it does not exist yet.
+func LoadHistory() (*UsageHistory, error) {
+	history := &UsageHistory{}
+
+	historyFile, err := paths.HistoryFile()
+	if err != nil {
+		return history, err
+	}
+
+	err = readJSON(historyFile, history)
+	return history, err
+}
+
+// RecordTaskUsage adds the path, icon, color and commands of task to the usage history.
+func RecordTaskUsage(task models.Task) error {
+	history, err := LoadHistory()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	history.Record(HistoryPaths, task.Path, now)
+	history.Record(HistoryIcons, task.Icon, now)
+	history.Record(HistoryColors, task.IconColor, now)
+	for _, cmd := range task.Cmds {
+		history.Record(HistoryCommands, cmd, now)
+	}
+	history.prune(now)
+
+	historyFile, err := paths.HistoryFile()
+	if err != nil {
+		return err
+	}
+	return writeJSON(historyFile, history)
+}
+
+// Record counts one more use of value. Blank values are ignored.
+func (h *UsageHistory) Record(kind HistoryKind, value string, now time.Time) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return
+	}
+
+	if h.Entries == nil {
+		h.Entries = make(map[HistoryKind]map[string]HistoryEntry)
+	}
+	if h.Entries[kind] == nil {
+		h.Entries[kind] = make(map[string]HistoryEntry)
+	}
+
+	entry := h.Entries[kind][value]
+	h.Entries[kind][value] = HistoryEntry{Count: entry.Count + 1, LastUsed: now}
+}
+
+// Frecency scores value by how often and how recently it was saved, from 0 (never) to maxFrecency.
+func (h *UsageHistory) Frecency(kind HistoryKind, value string, now time.Time) int {
+	entry, found := h.Entries[kind][value]
+	if !found {
+		return 0
+	}
+	return min(entry.Count*recencyWeight(now.Sub(entry.LastUsed)), maxFrecency)
+}
+
+// Values returns the recorded values of kind, most frecent first.
+func (h *UsageHistory) Values(kind HistoryKind, now time.Time) []string {
+	values := lo.Keys(h.Entries[kind])
+	sort.Slice(values, func(i, j int) bool {
+		fi, fj := h.Frecency(kind, values[i], now), h.Frecency(kind, values[j], now)
+		if fi != fj {
+			return fi > fj
+		}
+		return values[i] < values[j]
+	})
+	return values
+}
+
+// prune keeps the maxHistoryEntries most frecent values of every kind.
+func (h *UsageHistory) prune(now time.Time) {
+	for kind, entries := range h.Entries {
+		if len(entries) <= maxHistoryEntries {
+			continue
+		}
+		for _, value := range h.Values(kind, now)[maxHistoryEntries:] {
+			delete(entries, value)
+		}
+	}
+}
+
+// recencyWeight weighs a use by its age, so a value saved often long ago
+// ranks below one saved a few times this week.
+func recencyWeight(age time.Duration) int {
+	const day = 24 * time.Hour
+
+	switch {
+	case age < 4*day:
+		return 8
+	case age < 14*day:
+		return 6
+	case age < 31*day:
+		return 4
+	case age < 90*day:
+		return 2
+	default:
+		return 1
+	}
+}
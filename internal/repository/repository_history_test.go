@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestUsageHistory_Frecency(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	tests := []struct {
+		name     string
+		uses     []time.Time
+		expected int
+	}{
+		{name: "never used", uses: nil, expected: 0},
+		{name: "used once today", uses: []time.Time{now}, expected: 8},
+		{name: "used twice, last time last week", uses: []time.Time{now.Add(-30 * day), now.Add(-7 * day)}, expected: 12},
+		{name: "used once long ago", uses: []time.Time{now.Add(-200 * day)}, expected: 1},
+		{name: "boost is capped", uses: slices.Repeat([]time.Time{now}, 20), expected: maxFrecency},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			history := &UsageHistory{}
+			for _, use := range tt.uses {
+				history.Record(HistoryIcons, "rocket", use)
+			}
+
+			// Act
+			result := history.Frecency(HistoryIcons, "rocket", now)
+
+			// Assert
+			if result != tt.expected {
+				t.Errorf("expected frecency %d, got %d", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestUsageHistory_Values(t *testing.T) {
+	// Arrange
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	history := &UsageHistory{}
+	history.Record(HistoryPaths, "~/old", now.Add(-100*24*time.Hour))
+	history.Record(HistoryPaths, "~/old", now.Add(-100*24*time.Hour))
+	history.Record(HistoryPaths, "~/api", now)
+	history.Record(HistoryPaths, "  ", now)
+
+	// Act
+	result := history.Values(HistoryPaths, now)
+
+	// Assert
+	expected := []string{"~/api", "~/old"}
+	if !slices.Equal(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
@@ -0,0 +1,199 @@
+package repository
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/samber/lo"
+)
+
+type TasksBatchModel []models.Task
+
+// ConflictPolicy decides what happens to an imported task whose name is already taken.
+type ConflictPolicy string
+
+const (
+	ConflictSkip      ConflictPolicy = "skip"
+	ConflictOverwrite ConflictPolicy = "overwrite"
+	ConflictRename    ConflictPolicy = "rename"
+	ConflictFail      ConflictPolicy = "fail"
+)
+
+// ConflictPolicies lists every supported conflict policy.
+var ConflictPolicies = []ConflictPolicy{ConflictSkip, ConflictOverwrite, ConflictRename, ConflictFail}
+
+// ParseConflictPolicy converts a flag value into a ConflictPolicy.
+func ParseConflictPolicy(value string) (ConflictPolicy, error) {
+	policy := ConflictPolicy(strings.ToLower(strings.TrimSpace(value)))
+	if !lo.Contains(ConflictPolicies, policy) {
+		return "", fmt.Errorf("invalid conflict policy '%s' (expected skip, overwrite, rename or fail)", value)
+	}
+	return policy, nil
+}
+
+// ImportAction describes what happened to a single imported task.
+type ImportAction string
+
+const (
+	ImportCreated     ImportAction = "created"
+	ImportSkipped     ImportAction = "skipped"
+	ImportOverwritten ImportAction = "overwritten"
+	ImportRenamed     ImportAction = "renamed"
+	ImportInvalid     ImportAction = "invalid"
+)
+
+// ImportResult reports the outcome for one task of a batch import.
+type ImportResult struct {
+	Name      string
+	Action    ImportAction
+	RenamedTo string
+	Problems  []string // Validation problems of an invalid task
+}
+
+// TaskValidator returns a message for every problem found in a task, none when it is valid.
+type TaskValidator func(task models.Task) []string
+
+// SaveFromFile saves tasks from a given JSON file specified by a flag.
+// Tasks rejected by validate are not saved and are reported as invalid.
+// Name conflicts, with saved tasks or within the file, are resolved with policy.
+// With ConflictFail nothing is saved when any conflict is found.
+func SaveFromFile(path string, policy ConflictPolicy, validate TaskValidator) ([]ImportResult, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.New("failed to open file: " + err.Error())
+	}
+	defer file.Close()
+
+	var newTasks TasksBatchModel
+	err = json.NewDecoder(file).Decode(&newTasks)
+	if err != nil {
+		return nil, errors.New("Incorrect file format: " + err.Error())
+	}
+
+	content, err := readTasksContent()
+	if err != nil {
+		return nil, errors.New("Error when creating tasks:" + err.Error())
+	}
+
+	workspacesContent, err := readWorkspacesContent()
+	if err != nil {
+		return nil, errors.New("Error when creating tasks:" + err.Error())
+	}
+
+	validTasks, invalidResults := rejectInvalidTasks(newTasks, validate)
+
+	results, workspacesChanged, err := mergeTasks(&content, workspacesContent.Workspaces, validTasks, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	if workspacesChanged {
+		err = writeTasksAndWorkspaces(content, workspacesContent)
+	} else {
+		err = writeTasksContent(content)
+	}
+	if err != nil {
+		return nil, errors.New("Error when saving tasks:" + err.Error())
+	}
+
+	return append(results, invalidResults...), nil
+}
+
+// rejectInvalidTasks splits the tasks that pass validate from the others, which are reported
+// as invalid. Tasks without a name are identified by their position in the file.
+func rejectInvalidTasks(tasks []models.Task, validate TaskValidator) ([]models.Task, []ImportResult) {
+	var valid []models.Task
+	var invalid []ImportResult
+
+	for i, task := range tasks {
+		problems := validate(task)
+		if len(problems) == 0 {
+			valid = append(valid, task)
+			continue
+		}
+
+		name := task.Name
+		if strings.TrimSpace(name) == "" {
+			name = fmt.Sprintf("task #%d", i+1)
+		}
+		invalid = append(invalid, ImportResult{Name: name, Action: ImportInvalid, Problems: problems})
+	}
+
+	return valid, invalid
+}
+
+// mergeTasks adds newTasks to content following policy. Overwritten tasks are also replaced
+// in workspaces; the returned flag reports whether any workspace changed.
+func mergeTasks(content *TaskSaveFileContent, workspaces []models.Workspace, newTasks []models.Task, policy ConflictPolicy) ([]ImportResult, bool, error) {
+	results := make([]ImportResult, 0, len(newTasks))
+	workspacesChanged := false
+
+	if policy == ConflictFail {
+		if conflicts := findImportConflicts(content.Tasks, newTasks); len(conflicts) > 0 {
+			return nil, false, fmt.Errorf("%w: %s", ErrTaskExists, strings.Join(conflicts, ", "))
+		}
+	}
+
+	for _, task := range newTasks {
+		existing := findTaskIndex(content.Tasks, task.Name)
+		if existing == -1 {
+			content.Tasks = append(content.Tasks, task)
+			results = append(results, ImportResult{Name: task.Name, Action: ImportCreated})
+			continue
+		}
+
+		switch policy {
+		case ConflictOverwrite:
+			if replaceTaskInWorkspaces(workspaces, content.Tasks[existing].Name, task) {
+				workspacesChanged = true
+			}
+			content.Tasks[existing] = task
+			results = append(results, ImportResult{Name: task.Name, Action: ImportOverwritten})
+		case ConflictRename:
+			originalName := task.Name
+			task.Name = uniqueTaskName(content.Tasks, task.Name)
+			content.Tasks = append(content.Tasks, task)
+			results = append(results, ImportResult{Name: originalName, Action: ImportRenamed, RenamedTo: task.Name})
+		default:
+			results = append(results, ImportResult{Name: task.Name, Action: ImportSkipped})
+		}
+	}
+
+	return results, workspacesChanged, nil
+}
+
+// findImportConflicts returns the imported names that are already taken, by saved tasks or earlier imported ones.
+func findImportConflicts(saved []models.Task, newTasks []models.Task) []string {
+	var conflicts []string
+	seen := append([]models.Task{}, saved...)
+
+	for _, task := range newTasks {
+		if findTaskIndex(seen, task.Name) != -1 {
+			conflicts = append(conflicts, task.Name)
+		}
+		seen = append(seen, task)
+	}
+
+	return conflicts
+}
+
+// uniqueTaskName appends the first numeric suffix not used by another task to name ("api-2", "api-3", ...).
+func uniqueTaskName(tasks []models.Task, name string) string {
+	return uniqueName(name, func(candidate string) bool {
+		return findTaskIndex(tasks, candidate) != -1
+	})
+}
+
+// uniqueName appends the first numeric suffix for which taken reports false to name ("api-2", "api-3", ...).
+func uniqueName(name string, taken func(string) bool) string {
+	for i := 2; ; i++ {
+		candidate := fmt.Sprintf("%s-%d", name, i)
+		if !taken(candidate) {
+			return candidate
+		}
+	}
+}
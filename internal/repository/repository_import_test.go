@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/samber/lo"
+)
+
+func TestMergeTasks(t *testing.T) {
+	saved := []models.Task{{Name: "api", Cmds: []string{"go run ."}}}
+	incoming := []models.Task{
+		{Name: "API", Cmds: []string{"make run"}},
+		{Name: "web", Cmds: []string{"npm start"}},
+	}
+
+	tests := []struct {
+		name            string
+		policy          ConflictPolicy
+		expectedNames   []string
+		expectedActions []ImportAction
+		wantErr         bool
+	}{
+		{
+			name:            "skip keeps the saved task",
+			policy:          ConflictSkip,
+			expectedNames:   []string{"api", "web"},
+			expectedActions: []ImportAction{ImportSkipped, ImportCreated},
+		},
+		{
+			name:            "overwrite replaces the saved task",
+			policy:          ConflictOverwrite,
+			expectedNames:   []string{"API", "web"},
+			expectedActions: []ImportAction{ImportOverwritten, ImportCreated},
+		},
+		{
+			name:            "rename saves under a free name",
+			policy:          ConflictRename,
+			expectedNames:   []string{"api", "API-2", "web"},
+			expectedActions: []ImportAction{ImportRenamed, ImportCreated},
+		},
+		{
+			name:    "fail rejects the whole batch",
+			policy:  ConflictFail,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			content := TaskSaveFileContent{Tasks: append([]models.Task{}, saved...)}
+
+			// Act
+			results, _, err := mergeTasks(&content, nil, incoming, tt.policy)
+
+			// Assert
+			if tt.wantErr {
+				if !errors.Is(err, ErrTaskExists) {
+					t.Errorf("expected ErrTaskExists, got %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			names := lo.Map(content.Tasks, func(task models.Task, _ int) string { return task.Name })
+			if !lo.ElementsMatch(names, tt.expectedNames) {
+				t.Errorf("expected tasks %v, got %v", tt.expectedNames, names)
+			}
+
+			actions := lo.Map(results, func(result ImportResult, _ int) ImportAction { return result.Action })
+			if !lo.ElementsMatch(actions, tt.expectedActions) {
+				t.Errorf("expected actions %v, got %v", tt.expectedActions, actions)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/samber/lo"
+)
+
+// IsGlobPattern reports whether pattern contains glob metacharacters.
+func IsGlobPattern(pattern string) bool {
+	return strings.ContainsAny(pattern, "*?[")
+}
+
+// MatchTaskName reports whether a task name matches a name or glob pattern (case-insensitive).
+func MatchTaskName(pattern, name string) bool {
+	if !IsGlobPattern(pattern) {
+		return strings.EqualFold(pattern, name)
+	}
+
+	matched, err := path.Match(strings.ToLower(pattern), strings.ToLower(name))
+	return err == nil && matched
+}
+
+// MatchTasks resolves names and glob patterns (e.g. "api-*") to saved tasks.
+// Results keep the order of the patterns and are deduplicated.
+// Every pattern must match at least one task.
+func MatchTasks(patterns []string) ([]models.Task, error) {
+	tasks, err := ReadTasks()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tasks: %w", err)
+	}
+
+	var matched []models.Task
+	for _, pattern := range patterns {
+		if IsGlobPattern(pattern) {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("invalid pattern '%s': %w", pattern, err)
+			}
+		}
+
+		matches := lo.Filter(tasks, func(task models.Task, _ int) bool {
+			return MatchTaskName(pattern, task.Name)
+		})
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("no task matches '%s'", pattern)
+		}
+		matched = append(matched, matches...)
+	}
+
+	return lo.UniqBy(matched, func(task models.Task) string {
+		return strings.ToLower(task.Name)
+	}), nil
+}
+
+// HasTag reports whether the task carries tag (case-insensitive).
+func HasTag(task models.Task, tag string) bool {
+	return lo.ContainsBy(task.Tags, func(t string) bool {
+		return strings.EqualFold(t, tag)
+	})
+}
+
+// MatchTasksByTags returns the tasks carrying any of the tags, in saved order.
+// Every tag must match at least one task.
+func MatchTasksByTags(tags []string) ([]models.Task, error) {
+	tasks, err := ReadTasks()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tasks: %w", err)
+	}
+
+	for _, tag := range tags {
+		if !lo.SomeBy(tasks, func(task models.Task) bool { return HasTag(task, tag) }) {
+			return nil, fmt.Errorf("no task is tagged '%s'", tag)
+		}
+	}
+
+	return lo.Filter(tasks, func(task models.Task, _ int) bool {
+		return lo.SomeBy(tags, func(tag string) bool { return HasTag(task, tag) })
+	}), nil
+}
+
+// TaskMatchesQuery reports whether a task matches every term of a search query (case-insensitive).
+// Terms starting with # must be tags of the task; other terms must appear in its name, description or path.
+func TaskMatchesQuery(task models.Task, query string) bool {
+	return lo.EveryBy(strings.Fields(query), func(term string) bool {
+		if tag, isTag := strings.CutPrefix(term, "#"); isTag {
+			return tag == "" || lo.SomeBy(task.Tags, func(t string) bool {
+				return strings.HasPrefix(strings.ToLower(t), strings.ToLower(tag))
+			})
+		}
+
+		term = strings.ToLower(term)
+		return lo.SomeBy([]string{task.Name, task.Description, task.Path}, func(field string) bool {
+			return strings.Contains(strings.ToLower(field), term)
+		})
+	})
+}
+
+// AllTags returns every tag used by the saved tasks, deduplicated case-insensitively.
+func AllTags() ([]string, error) {
+	tasks, err := ReadTasks()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tasks: %w", err)
+	}
+
+	tags := lo.FlatMap(tasks, func(task models.Task, _ int) []string { return task.Tags })
+	return lo.UniqBy(tags, strings.ToLower), nil
+}
+
+// TaskNames returns the names of the tasks in order.
+func TaskNames(tasks []models.Task) []string {
+	return lo.Map(tasks, func(task models.Task, _ int) string { return task.Name })
+}
+
+// FindWorkspacesWithTask returns every workspace that contains the named task.
+func FindWorkspacesWithTask(taskName string) ([]models.Workspace, error) {
+	workspaces, err := ReadWorkspaces()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load workspaces: %w", err)
+	}
+
+	return lo.Filter(workspaces, func(ws models.Workspace, _ int) bool {
+		return workspaceContainsTask(ws, taskName)
+	}), nil
+}
+
+// workspaceContainsTask reports whether the workspace embeds a task with the given name.
+func workspaceContainsTask(workspace models.Workspace, taskName string) bool {
+	return lo.ContainsBy(workspace.Tasks, func(task models.Task) bool {
+		return strings.EqualFold(task.Name, taskName)
+	})
+}
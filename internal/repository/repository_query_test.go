@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+)
+
+func TestMatchTaskName(t *testing.T) {
+	tests := []struct {
+		name     string
+		pattern  string
+		taskName string
+		expected bool
+	}{
+		{name: "exact name", pattern: "api", taskName: "api", expected: true},
+		{name: "name is case-insensitive", pattern: "API", taskName: "api", expected: true},
+		{name: "different name", pattern: "api", taskName: "web", expected: false},
+		{name: "prefix glob", pattern: "api-*", taskName: "api-server", expected: true},
+		{name: "glob is case-insensitive", pattern: "API-*", taskName: "api-worker", expected: true},
+		{name: "glob without match", pattern: "api-*", taskName: "web-server", expected: false},
+		{name: "single character glob", pattern: "db?", taskName: "db1", expected: true},
+		{name: "invalid glob never matches", pattern: "api[", taskName: "api[", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			result := MatchTaskName(tt.pattern, tt.taskName)
+
+			// Assert
+			if result != tt.expected {
+				t.Errorf("MatchTaskName(%q, %q) = %v, expected %v", tt.pattern, tt.taskName, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTaskMatchesQuery(t *testing.T) {
+	task := models.Task{
+		Name:        "api-server",
+		Description: "REST backend",
+		Path:        "/srv/api",
+		Tags:        []string{"backend", "Go"},
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		expected bool
+	}{
+		{name: "empty query matches", query: "", expected: true},
+		{name: "name substring", query: "server", expected: true},
+		{name: "description substring", query: "rest", expected: true},
+		{name: "path substring", query: "/srv", expected: true},
+		{name: "tag", query: "#backend", expected: true},
+		{name: "tag prefix is case-insensitive", query: "#GO", expected: true},
+		{name: "lone hash matches", query: "#", expected: true},
+		{name: "missing tag", query: "#frontend", expected: false},
+		{name: "every term must match", query: "api #frontend", expected: false},
+		{name: "text and tag", query: "api #back", expected: true},
+		{name: "no match", query: "worker", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			result := TaskMatchesQuery(task, tt.query)
+
+			// Assert
+			if result != tt.expected {
+				t.Errorf("TaskMatchesQuery(%q) = %v, expected %v", tt.query, result, tt.expected)
+			}
+		})
+	}
+}
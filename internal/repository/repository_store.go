@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// replaceFile moves a staged temporary file over its target. Tests swap it to simulate failures.
+var replaceFile = os.Rename
+
+// storeWrite is one of the files replaced together by writeJSONFiles.
+type storeWrite struct {
+	path  string
+	value any
+}
+
+// storeBackup holds the content of a file before writeJSONFiles replaced it.
+type storeBackup struct {
+	content []byte
+	existed bool
+}
+
+// readJSON decodes the JSON file at path into target.
+// Missing or empty files are treated as empty stores and leave target untouched.
+func readJSON(path string, target any) error {
+	jsonContent, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(jsonContent) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(jsonContent, target)
+}
+
+// writeJSON atomically replaces the file at path with the JSON encoding of value.
+// Parent directories are created on first write.
+func writeJSON(path string, value any) error {
+	return writeJSONFiles(storeWrite{path: path, value: value})
+}
+
+// writeJSONFiles replaces several files with the JSON encoding of their values, all or nothing.
+// Every file is staged before any is replaced, and the files already replaced are restored
+// when a later one fails, so the stores never reference each other inconsistently.
+func writeJSONFiles(writes ...storeWrite) error {
+	staged := make([]string, 0, len(writes))
+	defer func() {
+		for _, tmp := range staged {
+			os.Remove(tmp)
+		}
+	}()
+
+	for _, write := range writes {
+		encoded, err := json.Marshal(write.value)
+		if err != nil {
+			return err
+		}
+		tmp, err := stageFile(write.path, encoded)
+		if err != nil {
+			return err
+		}
+		staged = append(staged, tmp)
+	}
+
+	backups := make([]storeBackup, len(writes))
+	for i, write := range writes {
+		content, err := os.ReadFile(write.path)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		backups[i] = storeBackup{content: content, existed: err == nil}
+	}
+
+	for i, write := range writes {
+		if err := replaceFile(staged[i], write.path); err != nil {
+			for j := i - 1; j >= 0; j-- {
+				err = errors.Join(err, restoreFile(writes[j].path, backups[j]))
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// stageFile writes content to a temporary file next to path, so replacing path with it is atomic.
+// Parent directories are created on first write.
+func stageFile(path string, content []byte) (string, error) {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	return tmp.Name(), nil
+}
+
+// restoreFile puts back the content path had before writeJSONFiles, removing it when it did not exist.
+func restoreFile(path string, backup storeBackup) error {
+	if !backup.existed {
+		return os.Remove(path)
+	}
+
+	tmp, err := stageFile(path, backup.content)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp)
+	return os.Rename(tmp, path)
+}
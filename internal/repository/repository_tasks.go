@@ -1,68 +1,66 @@
 package repository
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
-	"os"
-	"path"
-	"path/filepath"
 	"strings"
 
 	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/paths"
 	"github.com/samber/lo"
 )
 
-var (
-	// tasksSaveFile holds the absolute path to the tasks.json file in the user's config directory.
-	TasksSaveFile string
-)
+// TaskSaveFileContent represents the structure of the task persistence file.
+type TaskSaveFileContent struct {
+	Tasks []models.Task `json:"tasks"`
+}
 
-type TasksBatchModel []models.Task
+// readTasksContent loads the task persistence file, returning empty content when it does not exist yet.
+func readTasksContent() (TaskSaveFileContent, error) {
+	var content TaskSaveFileContent
 
-func init() {
-	cfgFolder, err := os.UserConfigDir()
+	tasksFile, err := paths.TasksFile()
 	if err != nil {
-		panic("could not determine user config directory: " + err.Error())
+		return content, err
 	}
-	TasksSaveFile = filepath.Join(cfgFolder, "vscode-terminal-runner", "tasks.json")
 
-	if _, err := os.Stat(TasksSaveFile); os.IsNotExist(err) {
-		if err := os.MkdirAll(filepath.Dir(TasksSaveFile), 0755); err != nil {
-			return
-		}
-		if _, err := os.Create(TasksSaveFile); err != nil {
-			return
-		}
-	}
+	err = readJSON(tasksFile, &content)
+	return content, err
 }
 
-// TaskSaveFileContent represents the structure of the task persistence file.
-type TaskSaveFileContent struct {
-	Tasks []models.Task `json:"tasks"`
+// writeTasksContent replaces the task persistence file with the given content.
+func writeTasksContent(content TaskSaveFileContent) error {
+	tasksFile, err := paths.TasksFile()
+	if err != nil {
+		return err
+	}
+	return writeJSON(tasksFile, content)
 }
 
-// ReadTasks loads all tasks from the persistence file.
-func ReadTasks() ([]models.Task, error) {
-	file, err := os.OpenFile(TasksSaveFile, os.O_RDWR|os.O_CREATE, 0666)
+// writeTasksAndWorkspaces replaces the task and workspace persistence files together,
+// so a failed write never leaves workspaces referencing tasks that were not saved.
+func writeTasksAndWorkspaces(content TaskSaveFileContent, workspacesContent WorkspaceSaveFileContent) error {
+	tasksFile, err := paths.TasksFile()
 	if err != nil {
-		return nil, err
+		return err
 	}
-	defer file.Close()
-
-	jsonContent, err := io.ReadAll(file)
+	workspacesFile, err := paths.WorkspacesFile()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	var content TaskSaveFileContent
-	if len(jsonContent) > 0 {
-		if err = json.Unmarshal(jsonContent, &content); err != nil {
-			return nil, err
-		}
-	}
+	return writeJSONFiles(
+		storeWrite{path: tasksFile, value: content},
+		storeWrite{path: workspacesFile, value: workspacesContent},
+	)
+}
 
+// ReadTasks loads all tasks from the persistence file.
+func ReadTasks() ([]models.Task, error) {
+	content, err := readTasksContent()
+	if err != nil {
+		return nil, err
+	}
 	return content.Tasks, nil
 }
 
@@ -84,172 +82,170 @@ func FindTaskByName(name string) (*models.Task, error) {
 	return &task, nil
 }
 
-// SaveTask saves a task to the local configuration file.
-func SaveTask(task models.Task) error {
-	if err := os.MkdirAll(path.Dir(TasksSaveFile), 0755); err != nil {
-		return err
-	}
+// ErrTaskExists is returned when a task name is already taken. Names are compared case-insensitively.
+var ErrTaskExists = errors.New("task already exists")
 
-	file, err := os.OpenFile(TasksSaveFile, os.O_RDWR|os.O_CREATE, 0666)
-	if err != nil {
-		return err
-	}
-	defer file.Close()
+// taskExistsError wraps ErrTaskExists with the conflicting name.
+func taskExistsError(name string) error {
+	return fmt.Errorf("%w: '%s'", ErrTaskExists, name)
+}
 
-	jsonContent, err := io.ReadAll(file)
+// SaveTask saves a task to the local configuration file.
+// Fails with ErrTaskExists when another task already uses the name.
+func SaveTask(task models.Task) error {
+	content, err := readTasksContent()
 	if err != nil {
 		return err
 	}
 
-	var content TaskSaveFileContent
-	if len(jsonContent) > 0 {
-		if err = json.Unmarshal(jsonContent, &content); err != nil {
-			return err
-		}
+	if existing := findTaskIndex(content.Tasks, task.Name); existing != -1 {
+		return taskExistsError(content.Tasks[existing].Name)
 	}
 
 	content.Tasks = append(content.Tasks, task)
 
-	newJsonContent, err := json.Marshal(content)
-	if err != nil {
-		return err
-	}
-
-	return os.WriteFile(TasksSaveFile, newJsonContent, 0666)
+	return writeTasksContent(content)
 }
 
-
-// SaveFromFile saves tasks from a given JSON file specified by a flag
-func SaveFromFile(path string) error {
-	file, err := os.Open(path)
+// UpdateTask modifies an existing task in the local configuration file.
+// Workspaces embed copies of their tasks, so every copy of the task is replaced as well.
+func UpdateTask(originalName string, updatedTask models.Task) error {
+	content, err := readTasksContent()
 	if err != nil {
-		return errors.New("failed to open file: " + err.Error())
+		return err
 	}
-	defer file.Close()
 
-	var newTasks TasksBatchModel
-	err = json.NewDecoder(file).Decode(&newTasks)
-	if err != nil {
-		return errors.New("Incorrect file format: " + err.Error())
+	taskIndex := findTaskIndex(content.Tasks, originalName)
+	if taskIndex == -1 {
+		return fmt.Errorf("task '%s' not found", originalName)
 	}
 
-	saveFile, err := os.Open(TasksSaveFile)
-	if err != nil {
-		return errors.New("Error when creating tasks:" + err.Error())
+	// Renaming must not collide with another task
+	if conflict := findTaskIndex(content.Tasks, updatedTask.Name); conflict != -1 && conflict != taskIndex {
+		return taskExistsError(content.Tasks[conflict].Name)
 	}
 
-	jsonBytes, err := io.ReadAll(saveFile)
+	workspacesContent, err := readWorkspacesContent()
 	if err != nil {
-		return errors.New("Error when creating tasks" + err.Error())
-	}
-
-	if len(jsonBytes) == 0 {
-		return errors.New("Provided file is empty")
+		return err
 	}
 
-	var content TaskSaveFileContent
-	if err = json.Unmarshal(jsonBytes, &content); err != nil {
-		return errors.New("Error when creating tasks:" + err.Error())
-	}
+	content.Tasks[taskIndex] = updatedTask
 
-	content.Tasks = append(content.Tasks, newTasks...)
-	newJsonContent, err := json.Marshal(content)
-	if err != nil {
-		return errors.New("Error when saving tasks:" + err.Error())
-	}
-	
-	err = os.WriteFile(TasksSaveFile, newJsonContent, 0666)
-	if err != nil {
-		return errors.New("Error when saving tasks:" + err.Error())
+	if replaceTaskInWorkspaces(workspacesContent.Workspaces, originalName, updatedTask) {
+		return writeTasksAndWorkspaces(content, workspacesContent)
 	}
-
-	return nil
+	return writeTasksContent(content)
 }
 
-// UpdateTask modifies an existing task in the local configuration file.
-func UpdateTask(originalName string, updatedTask models.Task) error {
-	if err := os.MkdirAll(path.Dir(TasksSaveFile), 0755); err != nil {
-		return err
+// RenameTask changes the name of a task and of every workspace copy of it.
+func RenameTask(oldName, newName string) error {
+	newName = strings.TrimSpace(newName)
+	if newName == "" {
+		return errors.New("new task name cannot be empty")
 	}
 
-	file, err := os.OpenFile(TasksSaveFile, os.O_RDWR|os.O_CREATE, 0666)
+	task, err := FindTaskByName(oldName)
 	if err != nil {
 		return err
 	}
-	defer file.Close()
 
-	jsonContent, err := io.ReadAll(file)
+	originalName := task.Name
+	task.Name = newName
+
+	return UpdateTask(originalName, *task)
+}
+
+// DuplicateTask saves a copy of the named task under the first free name ("api-2", "api-3", ...)
+// and returns the copy.
+func DuplicateTask(name string) (*models.Task, error) {
+	content, err := readTasksContent()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	var content TaskSaveFileContent
-	if len(jsonContent) > 0 {
-		if err = json.Unmarshal(jsonContent, &content); err != nil {
-			return err
-		}
+	taskIndex := findTaskIndex(content.Tasks, name)
+	if taskIndex == -1 {
+		return nil, fmt.Errorf("task '%s' not found", name)
 	}
 
-	// Find and replace the task
-	taskIndex := -1
-	for i, task := range content.Tasks {
-		if task.Name == originalName {
-			taskIndex = i
-			break
-		}
+	duplicate := content.Tasks[taskIndex]
+	duplicate.Name = uniqueTaskName(content.Tasks, duplicate.Name)
+	duplicate.Cmds = append([]string{}, duplicate.Cmds...)
+	duplicate.Tags = append([]string(nil), duplicate.Tags...)
+	content.Tasks = append(content.Tasks, duplicate)
+
+	if err := writeTasksContent(content); err != nil {
+		return nil, err
 	}
+	return &duplicate, nil
+}
 
-	if taskIndex == -1 {
-		return fmt.Errorf("task '%s' not found", originalName)
+// findTaskIndex returns the index of the task named name (case-insensitive), or -1.
+func findTaskIndex(tasks []models.Task, name string) int {
+	for i, task := range tasks {
+		if strings.EqualFold(task.Name, name) {
+			return i
+		}
 	}
+	return -1
+}
 
-	content.Tasks[taskIndex] = updatedTask
+// replaceTaskInWorkspaces swaps every copy of the named task for updatedTask, reporting whether any changed.
+func replaceTaskInWorkspaces(workspaces []models.Workspace, name string, updatedTask models.Task) bool {
+	replaced := false
 
-	newJsonContent, err := json.Marshal(content)
-	if err != nil {
-		return err
+	for i := range workspaces {
+		for j, task := range workspaces[i].Tasks {
+			if strings.EqualFold(task.Name, name) {
+				workspaces[i].Tasks[j] = updatedTask
+				replaced = true
+			}
+		}
 	}
 
-	return os.WriteFile(TasksSaveFile, newJsonContent, 0666)
+	return replaced
 }
 
 // DeleteTask removes a task from the local configuration file by name.
 func DeleteTask(name string) error {
-	if err := os.MkdirAll(path.Dir(TasksSaveFile), 0755); err != nil {
-		return err
-	}
-
-	file, err := os.OpenFile(TasksSaveFile, os.O_RDWR|os.O_CREATE, 0666)
-	if err != nil {
-		return err
-	}
-	defer file.Close()
+	return DeleteTasks([]string{name}, false)
+}
 
-	jsonContent, err := io.ReadAll(file)
+// DeleteTasks removes the named tasks (case-insensitive). When removeFromWorkspaces is set,
+// the tasks are also removed from every workspace that contains them.
+func DeleteTasks(names []string, removeFromWorkspaces bool) error {
+	content, err := readTasksContent()
 	if err != nil {
 		return err
 	}
 
-	var content TaskSaveFileContent
-	if len(jsonContent) > 0 {
-		if err = json.Unmarshal(jsonContent, &content); err != nil {
-			return err
-		}
+	isDeleted := func(task models.Task) bool {
+		return lo.ContainsBy(names, func(name string) bool {
+			return strings.EqualFold(task.Name, name)
+		})
 	}
 
-	content.Tasks = lo.Filter(content.Tasks, func(task models.Task, index int) bool {
-		return task.Name != name
+	content.Tasks = lo.Reject(content.Tasks, func(task models.Task, _ int) bool {
+		return isDeleted(task)
 	})
 
-	encoded, err := json.Marshal(content)
+	if !removeFromWorkspaces {
+		return writeTasksContent(content)
+	}
+
+	workspacesContent, err := readWorkspacesContent()
 	if err != nil {
 		return err
 	}
 
-	file.Truncate(0)
-	file.Seek(0, 0)
-	_, err = file.Write(encoded)
-	return err
+	for i, ws := range workspacesContent.Workspaces {
+		workspacesContent.Workspaces[i].Tasks = lo.Reject(ws.Tasks, func(task models.Task, _ int) bool {
+			return isDeleted(task)
+		})
+	}
+
+	return writeTasksAndWorkspaces(content, workspacesContent)
 }
 
 // GetAllTasks retrieves all saved tasks.
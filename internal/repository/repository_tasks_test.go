@@ -0,0 +1,216 @@
+package repository
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/paths"
+	"github.com/samber/lo"
+)
+
+// seedStores points the stores at a temporary directory and saves tasks and workspaces in it.
+func seedStores(t *testing.T, tasks []models.Task, workspaces []models.Workspace) {
+	t.Helper()
+	t.Setenv(paths.ConfigDirEnv, t.TempDir())
+	t.Setenv(paths.ProfileEnv, "")
+
+	if err := writeTasksContent(TaskSaveFileContent{Tasks: tasks}); err != nil {
+		t.Fatalf("failed to seed tasks: %v", err)
+	}
+	if err := writeWorkspacesContent(WorkspaceSaveFileContent{Workspaces: workspaces}); err != nil {
+		t.Fatalf("failed to seed workspaces: %v", err)
+	}
+}
+
+// failReplacing makes replacing the store named fileName fail for the rest of the test.
+func failReplacing(t *testing.T, fileName string) {
+	t.Helper()
+	replaceFile = func(tmp, path string) error {
+		if filepath.Base(path) == fileName {
+			return errors.New("disk full")
+		}
+		return os.Rename(tmp, path)
+	}
+	t.Cleanup(func() { replaceFile = os.Rename })
+}
+
+// storedNames returns the names of the saved tasks and of the tasks of every saved workspace.
+func storedNames(t *testing.T) ([]string, map[string][]string) {
+	t.Helper()
+	tasks, err := ReadTasks()
+	if err != nil {
+		t.Fatalf("failed to read tasks: %v", err)
+	}
+	workspaces, err := ReadWorkspaces()
+	if err != nil {
+		t.Fatalf("failed to read workspaces: %v", err)
+	}
+
+	workspaceTasks := make(map[string][]string)
+	for _, ws := range workspaces {
+		workspaceTasks[ws.Name] = lo.Map(ws.Tasks, func(task models.Task, _ int) string { return task.Name })
+	}
+	return lo.Map(tasks, func(task models.Task, _ int) string { return task.Name }), workspaceTasks
+}
+
+func TestDeleteTasks(t *testing.T) {
+	api := models.Task{Name: "api", Cmds: []string{"go run ."}}
+	web := models.Task{Name: "web", Cmds: []string{"npm start"}}
+	worker := models.Task{Name: "worker", Cmds: []string{"go run ./cmd/worker"}}
+
+	tests := []struct {
+		name                   string
+		names                  []string
+		removeFromWorkspaces   bool
+		expectedTasks          []string
+		expectedWorkspaceTasks []string
+	}{
+		{
+			name:                   "deletes the tasks and keeps workspaces untouched",
+			names:                  []string{"worker"},
+			expectedTasks:          []string{"api", "web"},
+			expectedWorkspaceTasks: []string{"api", "web"},
+		},
+		{
+			name:                   "names are case-insensitive",
+			names:                  []string{"WEB", "Worker"},
+			removeFromWorkspaces:   true,
+			expectedTasks:          []string{"api"},
+			expectedWorkspaceTasks: []string{"api"},
+		},
+		{
+			name:                   "removes the tasks from their workspaces",
+			names:                  []string{"api"},
+			removeFromWorkspaces:   true,
+			expectedTasks:          []string{"web", "worker"},
+			expectedWorkspaceTasks: []string{"web"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			seedStores(t, []models.Task{api, web, worker}, []models.Workspace{{Name: "dev", Tasks: []models.Task{api, web}}})
+
+			// Act
+			err := DeleteTasks(tt.names, tt.removeFromWorkspaces)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			tasks, workspaceTasks := storedNames(t)
+			if !slices.Equal(tasks, tt.expectedTasks) {
+				t.Errorf("expected tasks %v, got %v", tt.expectedTasks, tasks)
+			}
+			if !slices.Equal(workspaceTasks["dev"], tt.expectedWorkspaceTasks) {
+				t.Errorf("expected workspace tasks %v, got %v", tt.expectedWorkspaceTasks, workspaceTasks["dev"])
+			}
+		})
+	}
+}
+
+func TestDeleteTasks_FailedWriteKeepsStores(t *testing.T) {
+	// Arrange
+	api := models.Task{Name: "api", Cmds: []string{"go run ."}}
+	web := models.Task{Name: "web", Cmds: []string{"npm start"}}
+	seedStores(t, []models.Task{api, web}, []models.Workspace{{Name: "dev", Tasks: []models.Task{api, web}}})
+	failReplacing(t, "workspaces.json")
+
+	// Act
+	err := DeleteTasks([]string{"api"}, true)
+
+	// Assert
+	if err == nil {
+		t.Fatal("expected the failed workspace write to be reported")
+	}
+	tasks, workspaceTasks := storedNames(t)
+	if !slices.Equal(tasks, []string{"api", "web"}) {
+		t.Errorf("expected the tasks file to be restored, got %v", tasks)
+	}
+	if !slices.Equal(workspaceTasks["dev"], []string{"api", "web"}) {
+		t.Errorf("expected workspaces to be untouched, got %v", workspaceTasks["dev"])
+	}
+}
+
+func TestRenameTask(t *testing.T) {
+	api := models.Task{Name: "api", Cmds: []string{"go run ."}}
+	web := models.Task{Name: "web", Cmds: []string{"npm start"}}
+
+	tests := []struct {
+		name                   string
+		oldName                string
+		newName                string
+		expectedErr            error
+		expectedTasks          []string
+		expectedWorkspaceTasks []string
+	}{
+		{
+			name:                   "renames the task in every workspace",
+			oldName:                "api",
+			newName:                "backend",
+			expectedTasks:          []string{"backend", "web"},
+			expectedWorkspaceTasks: []string{"backend", "web"},
+		},
+		{
+			name:                   "case-only rename is not a conflict",
+			oldName:                "api",
+			newName:                "API",
+			expectedTasks:          []string{"API", "web"},
+			expectedWorkspaceTasks: []string{"API", "web"},
+		},
+		{
+			name:                   "name of another task is rejected regardless of case",
+			oldName:                "api",
+			newName:                "Web",
+			expectedErr:            ErrTaskExists,
+			expectedTasks:          []string{"api", "web"},
+			expectedWorkspaceTasks: []string{"api", "web"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			seedStores(t, []models.Task{api, web}, []models.Workspace{{Name: "dev", Tasks: []models.Task{api, web}}})
+
+			// Act
+			err := RenameTask(tt.oldName, tt.newName)
+
+			// Assert
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tt.expectedErr, err)
+			}
+			tasks, workspaceTasks := storedNames(t)
+			if !slices.Equal(tasks, tt.expectedTasks) {
+				t.Errorf("expected tasks %v, got %v", tt.expectedTasks, tasks)
+			}
+			if !slices.Equal(workspaceTasks["dev"], tt.expectedWorkspaceTasks) {
+				t.Errorf("expected workspace tasks %v, got %v", tt.expectedWorkspaceTasks, workspaceTasks["dev"])
+			}
+		})
+	}
+}
+
+func TestRenameTask_FailedWriteKeepsStores(t *testing.T) {
+	// Arrange
+	api := models.Task{Name: "api", Cmds: []string{"go run ."}}
+	seedStores(t, []models.Task{api}, []models.Workspace{{Name: "dev", Tasks: []models.Task{api}}})
+	failReplacing(t, "workspaces.json")
+
+	// Act
+	err := RenameTask("api", "backend")
+
+	// Assert
+	if err == nil {
+		t.Fatal("expected the failed workspace write to be reported")
+	}
+	tasks, workspaceTasks := storedNames(t)
+	if !slices.Equal(tasks, []string{"api"}) || !slices.Equal(workspaceTasks["dev"], []string{"api"}) {
+		t.Errorf("expected both stores to keep the old name, got tasks %v and workspace tasks %v", tasks, workspaceTasks["dev"])
+	}
+}
@@ -2,63 +2,47 @@
 package repository
 
 import (
-	"encoding/json"
 	"fmt"
-	"io"
-	"os"
-	"path"
 	"strings"
 
 	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/paths"
 	"github.com/samber/lo"
 )
 
-var (
-	WorkspacesSaveFile string
-)
+// WorkspaceSaveFileContent represents the structure of the workspace persistence file.
+type WorkspaceSaveFileContent struct {
+	Workspaces []models.Workspace `json:"workspaces"`
+}
 
-func init() {
-	cfgFolder, err := os.UserConfigDir()
+// readWorkspacesContent loads the workspace persistence file, returning empty content when it does not exist yet.
+func readWorkspacesContent() (WorkspaceSaveFileContent, error) {
+	var content WorkspaceSaveFileContent
+
+	workspacesFile, err := paths.WorkspacesFile()
 	if err != nil {
-		panic("could not determine user config directory: " + err.Error())
+		return content, err
 	}
-	WorkspacesSaveFile = path.Join(cfgFolder, "vscode-terminal-runner", "workspaces.json")
 
-	if _, err := os.Stat(WorkspacesSaveFile); os.IsNotExist(err) {
-		if err := os.MkdirAll(path.Dir(WorkspacesSaveFile), 0755); err != nil {
-			return
-		}
-		if _, err := os.Create(WorkspacesSaveFile); err != nil {
-			return
-		}
-	}
+	err = readJSON(workspacesFile, &content)
+	return content, err
 }
 
-// WorkspaceSaveFileContent represents the structure of the workspace persistence file.
-type WorkspaceSaveFileContent struct {
-	Workspaces []models.Workspace `json:"workspaces"`
+// writeWorkspacesContent replaces the workspace persistence file with the given content.
+func writeWorkspacesContent(content WorkspaceSaveFileContent) error {
+	workspacesFile, err := paths.WorkspacesFile()
+	if err != nil {
+		return err
+	}
+	return writeJSON(workspacesFile, content)
 }
 
 // ReadWorkspaces loads all workspaces from the persistence file.
 func ReadWorkspaces() ([]models.Workspace, error) {
-	file, err := os.OpenFile(WorkspacesSaveFile, os.O_RDWR|os.O_CREATE, 0666)
+	content, err := readWorkspacesContent()
 	if err != nil {
 		return nil, err
 	}
-	defer file.Close()
-
-	jsonContent, err := io.ReadAll(file)
-	if err != nil {
-		return nil, err
-	}
-
-	var content WorkspaceSaveFileContent
-	if len(jsonContent) > 0 {
-		if err = json.Unmarshal(jsonContent, &content); err != nil {
-			return nil, err
-		}
-	}
-
 	return content.Workspaces, nil
 }
 
@@ -82,79 +66,187 @@ func FindWorkspaceByName(name string) (*models.Workspace, error) {
 
 // SaveWorkspace saves a workspace to the local configuration file.
 func SaveWorkspace(workspace models.Workspace) error {
-	if err := os.MkdirAll(path.Dir(WorkspacesSaveFile), 0755); err != nil {
+	content, err := readWorkspacesContent()
+	if err != nil {
 		return err
 	}
 
-	file, err := os.OpenFile(WorkspacesSaveFile, os.O_RDWR|os.O_CREATE, 0666)
-	if err != nil {
-		return err
+	if findWorkspaceIndex(content.Workspaces, workspace.Name) != -1 {
+		return fmt.Errorf("workspace '%s' already exists", workspace.Name)
 	}
-	defer file.Close()
 
-	jsonContent, err := io.ReadAll(file)
+	content.Workspaces = append(content.Workspaces, workspace)
+
+	return writeWorkspacesContent(content)
+}
+
+// UpdateWorkspace replaces an existing workspace, keeping its position in the persistence file.
+func UpdateWorkspace(originalName string, updatedWorkspace models.Workspace) error {
+	content, err := readWorkspacesContent()
 	if err != nil {
 		return err
 	}
 
-	var content WorkspaceSaveFileContent
-	if len(jsonContent) > 0 {
-		if err = json.Unmarshal(jsonContent, &content); err != nil {
-			return err
-		}
+	workspaceIndex := findWorkspaceIndex(content.Workspaces, originalName)
+	if workspaceIndex == -1 {
+		return fmt.Errorf("workspace '%s' not found", originalName)
 	}
 
-	if _, found := lo.Find(content.Workspaces, func(ws models.Workspace) bool {
-		return ws.Name == workspace.Name
-	}); found {
-		return fmt.Errorf("workspace '%s' already exists", workspace.Name)
+	// Renaming must not collide with another workspace
+	if conflict := findWorkspaceIndex(content.Workspaces, updatedWorkspace.Name); conflict != -1 && conflict != workspaceIndex {
+		return fmt.Errorf("workspace '%s' already exists", content.Workspaces[conflict].Name)
 	}
 
-	content.Workspaces = append(content.Workspaces, workspace)
+	content.Workspaces[workspaceIndex] = updatedWorkspace
 
-	newJsonContent, err := json.Marshal(content)
+	return writeWorkspacesContent(content)
+}
+
+// RenameWorkspace changes the name of a workspace.
+func RenameWorkspace(oldName, newName string) error {
+	newName = strings.TrimSpace(newName)
+	if newName == "" {
+		return fmt.Errorf("new workspace name cannot be empty")
+	}
+
+	workspace, err := FindWorkspaceByName(oldName)
 	if err != nil {
 		return err
 	}
 
-	return os.WriteFile(WorkspacesSaveFile, newJsonContent, 0666)
+	originalName := workspace.Name
+	workspace.Name = newName
+
+	return UpdateWorkspace(originalName, *workspace)
 }
 
-// DeleteWorkspace removes a workspace from the local configuration file by name.
+// DeleteWorkspace removes a workspace from the local configuration file by name (case-insensitive).
 func DeleteWorkspace(name string) error {
-	if err := os.MkdirAll(path.Dir(WorkspacesSaveFile), 0755); err != nil {
+	content, err := readWorkspacesContent()
+	if err != nil {
 		return err
 	}
 
-	file, err := os.OpenFile(WorkspacesSaveFile, os.O_RDWR|os.O_CREATE, 0666)
-	if err != nil {
-		return err
+	remaining := lo.Filter(content.Workspaces, func(ws models.Workspace, _ int) bool {
+		return !strings.EqualFold(ws.Name, name)
+	})
+	if len(remaining) == len(content.Workspaces) {
+		return fmt.Errorf("workspace '%s' not found", name)
 	}
-	defer file.Close()
+	content.Workspaces = remaining
+
+	return writeWorkspacesContent(content)
+}
 
-	jsonContent, err := io.ReadAll(file)
+// DuplicateWorkspace saves a copy of the named workspace under the first free name ("dev-2", "dev-3", ...)
+// and returns the copy.
+func DuplicateWorkspace(name string) (*models.Workspace, error) {
+	content, err := readWorkspacesContent()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	var content WorkspaceSaveFileContent
-	if len(jsonContent) > 0 {
-		if err = json.Unmarshal(jsonContent, &content); err != nil {
-			return err
-		}
+	workspaceIndex := findWorkspaceIndex(content.Workspaces, name)
+	if workspaceIndex == -1 {
+		return nil, fmt.Errorf("workspace '%s' not found", name)
 	}
 
-	content.Workspaces = lo.Filter(content.Workspaces, func(ws models.Workspace, _ int) bool {
-		return ws.Name != name
+	duplicate := content.Workspaces[workspaceIndex]
+	duplicate.Tasks = append([]models.Task{}, duplicate.Tasks...)
+	duplicate.Name = uniqueName(duplicate.Name, func(candidate string) bool {
+		return findWorkspaceIndex(content.Workspaces, candidate) != -1
 	})
+	content.Workspaces = append(content.Workspaces, duplicate)
+
+	if err := writeWorkspacesContent(content); err != nil {
+		return nil, err
+	}
+	return &duplicate, nil
+}
+
+// findWorkspaceIndex returns the index of the workspace named name (case-insensitive), or -1.
+func findWorkspaceIndex(workspaces []models.Workspace, name string) int {
+	for i, ws := range workspaces {
+		if strings.EqualFold(ws.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// modifyWorkspace loads the named workspace, applies change and saves the result in place.
+func modifyWorkspace(name string, change func(workspace *models.Workspace, tasks []models.Task) error) error {
+	content, err := readWorkspacesContent()
+	if err != nil {
+		return err
+	}
+
+	workspaceIndex := findWorkspaceIndex(content.Workspaces, name)
+	if workspaceIndex == -1 {
+		return fmt.Errorf("workspace '%s' not found", name)
+	}
 
-	encoded, err := json.Marshal(content)
+	tasks, err := ReadTasks()
 	if err != nil {
+		return fmt.Errorf("failed to load tasks: %w", err)
+	}
+
+	if err := change(&content.Workspaces[workspaceIndex], tasks); err != nil {
 		return err
 	}
 
-	file.Truncate(0)
-	file.Seek(0, 0)
-	_, err = file.Write(encoded)
-	return err
+	return writeWorkspacesContent(content)
+}
+
+// AddTasksToWorkspace appends saved tasks to a workspace. Every task must exist and not be in the workspace yet.
+func AddTasksToWorkspace(workspaceName string, taskNames []string) error {
+	return modifyWorkspace(workspaceName, func(workspace *models.Workspace, tasks []models.Task) error {
+		for _, name := range taskNames {
+			taskIndex := findTaskIndex(tasks, name)
+			if taskIndex == -1 {
+				return fmt.Errorf("task '%s' not found", name)
+			}
+			if workspaceContainsTask(*workspace, name) {
+				return fmt.Errorf("task '%s' is already in workspace '%s'", tasks[taskIndex].Name, workspace.Name)
+			}
+			workspace.Tasks = append(workspace.Tasks, tasks[taskIndex])
+		}
+		return nil
+	})
+}
+
+// RemoveTasksFromWorkspace removes tasks from a workspace. The tasks themselves are kept.
+func RemoveTasksFromWorkspace(workspaceName string, taskNames []string) error {
+	return modifyWorkspace(workspaceName, func(workspace *models.Workspace, _ []models.Task) error {
+		for _, name := range taskNames {
+			taskIndex := findTaskIndex(workspace.Tasks, name)
+			if taskIndex == -1 {
+				return fmt.Errorf("task '%s' is not in workspace '%s'", name, workspace.Name)
+			}
+			workspace.Tasks = append(workspace.Tasks[:taskIndex], workspace.Tasks[taskIndex+1:]...)
+		}
+		return nil
+	})
+}
+
+// MoveTaskInWorkspace moves a task to a new launch position (1-based) inside a workspace.
+func MoveTaskInWorkspace(workspaceName, taskName string, position int) error {
+	return modifyWorkspace(workspaceName, func(workspace *models.Workspace, _ []models.Task) error {
+		taskIndex := findTaskIndex(workspace.Tasks, taskName)
+		if taskIndex == -1 {
+			return fmt.Errorf("task '%s' is not in workspace '%s'", taskName, workspace.Name)
+		}
+		if position < 1 || position > len(workspace.Tasks) {
+			return fmt.Errorf("position %d is out of range (1-%d)", position, len(workspace.Tasks))
+		}
+
+		task := workspace.Tasks[taskIndex]
+		remaining := lo.Reject(workspace.Tasks, func(_ models.Task, i int) bool { return i == taskIndex })
+
+		reordered := make([]models.Task, 0, len(workspace.Tasks))
+		reordered = append(reordered, remaining[:position-1]...)
+		reordered = append(reordered, task)
+		workspace.Tasks = append(reordered, remaining[position-1:]...)
+		return nil
+	})
 }
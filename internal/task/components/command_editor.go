@@ -0,0 +1,208 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/samber/lo"
+)
+
+// UI Layout constants
+const (
+	commandInputWidth    = 65 // Width of a command row, without the "NN. " prefix
+	maxCommandRowHeight  = 4  // Lines shown at once for a long command, the rest scrolls
+	commandNumberPadding = 2  // Width of the row number
+)
+
+// CommandEditor edits the commands of a task as a list, one row per command.
+// The focused row is a textarea, so long commands wrap instead of scrolling out of view,
+// and commands may contain commas.
+type CommandEditor struct {
+	commands     []string
+	focusedIndex int
+	input        textarea.Model
+}
+
+// NewCommandEditor creates a command editor holding commands. Blank commands are dropped.
+func NewCommandEditor(commands []string) *CommandEditor {
+	input := textarea.New()
+	input.Prompt = ""
+	input.Placeholder = "e.g., yarn dev"
+	input.ShowLineNumbers = false
+	input.CharLimit = 0
+	input.SetWidth(commandInputWidth)
+	input.FocusedStyle.CursorLine = lipgloss.NewStyle()
+	input.FocusedStyle.Text = styles.FocusedInputStyle
+	input.FocusedStyle.Placeholder = styles.PlaceholderStyle
+	input.BlurredStyle.Placeholder = styles.PlaceholderStyle
+
+	// Commands are single lines, rows are changed by the editor itself
+	input.KeyMap.InsertNewline.SetEnabled(false)
+	input.KeyMap.LineNext.SetEnabled(false)
+	input.KeyMap.LinePrevious.SetEnabled(false)
+
+	rows := lo.Filter(commands, func(cmd string, _ int) bool { return strings.TrimSpace(cmd) != "" })
+	if len(rows) == 0 {
+		rows = []string{""}
+	}
+
+	ce := &CommandEditor{commands: rows, input: input}
+	ce.loadFocused()
+	return ce
+}
+
+// Commands returns the trimmed commands in order, without blank rows.
+func (ce *CommandEditor) Commands() []string {
+	ce.syncFocused()
+	trimmed := lo.Map(ce.commands, func(cmd string, _ int) string { return strings.TrimSpace(cmd) })
+	return lo.Compact(trimmed)
+}
+
+// FocusedCommand returns the text of the focused row.
+func (ce *CommandEditor) FocusedCommand() string {
+	return ce.input.Value()
+}
+
+// SetFocusedCommand replaces the text of the focused row, such as with an applied suggestion.
+func (ce *CommandEditor) SetFocusedCommand(cmd string) {
+	ce.commands[ce.focusedIndex] = cmd
+	ce.loadFocused()
+}
+
+// Focus focuses the current row.
+func (ce *CommandEditor) Focus() tea.Cmd {
+	return ce.input.Focus()
+}
+
+// Blur removes the focus from the editor.
+func (ce *CommandEditor) Blur() {
+	ce.input.Blur()
+}
+
+// MoveFocus focuses the previous (-1) or next (1) row.
+// It reports false when there is no row in that direction, so the form can move to another field.
+func (ce *CommandEditor) MoveFocus(direction int) bool {
+	target := ce.focusedIndex + direction
+	if target < 0 || target >= len(ce.commands) {
+		return false
+	}
+
+	ce.syncFocused()
+	ce.focusedIndex = target
+	ce.loadFocused()
+	return true
+}
+
+// AddCommand inserts an empty row after the focused one and focuses it.
+// Nothing happens while the focused row is still empty.
+func (ce *CommandEditor) AddCommand() {
+	ce.syncFocused()
+	if strings.TrimSpace(ce.commands[ce.focusedIndex]) == "" {
+		return
+	}
+
+	ce.focusedIndex++
+	ce.commands = lo.Splice(ce.commands, ce.focusedIndex, "")
+	ce.loadFocused()
+}
+
+// RemoveFocused removes the focused row. The last remaining row is cleared instead.
+func (ce *CommandEditor) RemoveFocused() {
+	if len(ce.commands) == 1 {
+		ce.commands[0] = ""
+		ce.loadFocused()
+		return
+	}
+
+	ce.commands = lo.Reject(ce.commands, func(_ string, i int) bool { return i == ce.focusedIndex })
+	ce.focusedIndex = min(ce.focusedIndex, len(ce.commands)-1)
+	ce.loadFocused()
+}
+
+// MoveCommand moves the focused command earlier (-1) or later (1) in the list.
+func (ce *CommandEditor) MoveCommand(direction int) {
+	target := ce.focusedIndex + direction
+	if target < 0 || target >= len(ce.commands) {
+		return
+	}
+
+	ce.syncFocused()
+	ce.commands[ce.focusedIndex], ce.commands[target] = ce.commands[target], ce.commands[ce.focusedIndex]
+	ce.focusedIndex = target
+	ce.loadFocused()
+}
+
+// Update edits the focused row. Pasted text spanning several lines becomes one row per line.
+func (ce *CommandEditor) Update(msg tea.Msg) tea.Cmd {
+	var cmd tea.Cmd
+	ce.input, cmd = ce.input.Update(msg)
+
+	if value := ce.input.Value(); strings.Contains(value, "\n") {
+		ce.splitFocused(strings.Split(value, "\n"))
+	}
+	ce.resizeInput()
+
+	return cmd
+}
+
+// splitFocused replaces the focused row with lines, focusing the last of them.
+func (ce *CommandEditor) splitFocused(lines []string) {
+	before := append([]string{}, ce.commands[:ce.focusedIndex]...)
+	after := ce.commands[ce.focusedIndex+1:]
+
+	ce.commands = append(append(before, lines...), after...)
+	ce.focusedIndex += len(lines) - 1
+	ce.loadFocused()
+}
+
+// syncFocused copies the textarea value into the focused row.
+func (ce *CommandEditor) syncFocused() {
+	ce.commands[ce.focusedIndex] = ce.input.Value()
+}
+
+// loadFocused shows the focused row in the textarea, with the cursor at its end.
+func (ce *CommandEditor) loadFocused() {
+	ce.input.SetValue(ce.commands[ce.focusedIndex])
+	ce.input.CursorEnd()
+	ce.resizeInput()
+}
+
+// resizeInput grows the textarea with the wrapped length of the focused command.
+func (ce *CommandEditor) resizeInput() {
+	wrapped := lipgloss.NewStyle().Width(commandInputWidth).Render(ce.input.Value())
+	ce.input.SetHeight(min(max(lipgloss.Height(wrapped), 1), maxCommandRowHeight))
+}
+
+// View renders the numbered rows, the focused one as an editable textarea.
+func (ce *CommandEditor) View() string {
+	rows := make([]string, 0, len(ce.commands))
+
+	for i, cmd := range ce.commands {
+		number := fmt.Sprintf("%*d. ", commandNumberPadding, i+1)
+
+		if i == ce.focusedIndex && ce.input.Focused() {
+			rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
+				styles.FocusedInputStyle.Render(number), ce.input.View()))
+			continue
+		}
+
+		if i == ce.focusedIndex {
+			cmd = ce.input.Value()
+		}
+		if strings.TrimSpace(cmd) == "" {
+			cmd = styles.PlaceholderStyle.Render(ce.input.Placeholder)
+		}
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top,
+			styles.LightGrayStyle.Render(number), lipgloss.NewStyle().Width(commandInputWidth).Render(cmd)))
+	}
+
+	if ce.input.Focused() {
+		rows = append(rows, styles.LightGrayStyle.Render("enter new command • tab apply suggestion • ctrl+x remove • shift+↑/↓ reorder"))
+	}
+
+	return strings.Join(rows, "\n")
+}
@@ -0,0 +1,95 @@
+package components
+
+import (
+	"slices"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func typeText(ce *CommandEditor, text string) {
+	ce.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(text)})
+}
+
+func TestCommandEditor(t *testing.T) {
+	tests := []struct {
+		name     string
+		initial  []string
+		actions  func(ce *CommandEditor)
+		expected []string
+	}{
+		{
+			name:     "commands with commas round-trip",
+			initial:  []string{"jq '.a, .b' data.json", "echo a,b"},
+			actions:  func(ce *CommandEditor) {},
+			expected: []string{"jq '.a, .b' data.json", "echo a,b"},
+		},
+		{
+			name:     "commands are trimmed and blank rows dropped",
+			initial:  []string{"  yarn dev  ", "   "},
+			actions:  func(ce *CommandEditor) {},
+			expected: []string{"yarn dev"},
+		},
+		{
+			name:    "add a command after the focused one",
+			initial: []string{"yarn install", "yarn dev"},
+			actions: func(ce *CommandEditor) {
+				ce.AddCommand()
+				typeText(ce, "yarn build")
+			},
+			expected: []string{"yarn install", "yarn build", "yarn dev"},
+		},
+		{
+			name:    "an empty row is not added twice",
+			initial: []string{"yarn dev"},
+			actions: func(ce *CommandEditor) {
+				ce.AddCommand()
+				ce.AddCommand()
+				typeText(ce, "yarn test")
+			},
+			expected: []string{"yarn dev", "yarn test"},
+		},
+		{
+			name:    "remove the focused command",
+			initial: []string{"a", "b", "c"},
+			actions: func(ce *CommandEditor) {
+				ce.MoveFocus(1)
+				ce.RemoveFocused()
+			},
+			expected: []string{"a", "c"},
+		},
+		{
+			name:    "move a command later",
+			initial: []string{"a", "b", "c"},
+			actions: func(ce *CommandEditor) {
+				ce.MoveCommand(1)
+				ce.MoveCommand(1)
+			},
+			expected: []string{"b", "c", "a"},
+		},
+		{
+			name:    "pasted lines become rows",
+			initial: nil,
+			actions: func(ce *CommandEditor) {
+				typeText(ce, "make deps\nmake run")
+			},
+			expected: []string{"make deps", "make run"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ce := NewCommandEditor(tt.initial)
+			ce.Focus()
+
+			// Act
+			tt.actions(ce)
+
+			// Assert
+			if result := ce.Commands(); !slices.Equal(result, tt.expected) {
+				t.Errorf("expected commands %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package components
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// CommandProvider suggests the commands a project defines, mined from the files in its directory:
+// package.json scripts, Makefile targets, Go mains, cargo, docker compose and Procfile entries.
+// It implements suggestions.Provider, keyed by the directory set with SetDirectory.
+type CommandProvider struct {
+	directory string
+}
+
+// commandDetector returns the commands a kind of project file defines in dir
+type commandDetector func(dir string) []string
+
+// commandDetectors run in order, so the suggestions list package scripts first
+var commandDetectors = []commandDetector{
+	packageScripts,
+	makeTargets,
+	goMains,
+	cargoCommands,
+	composeCommands,
+	procfileCommands,
+}
+
+// NewCommandProvider creates a command provider without a directory, which suggests nothing.
+func NewCommandProvider() *CommandProvider {
+	return &CommandProvider{}
+}
+
+// SetDirectory sets the project directory the commands are mined from.
+func (cp *CommandProvider) SetDirectory(dir string) {
+	cp.directory = strings.TrimSpace(dir)
+	if cp.directory != "" {
+		cp.directory = filepath.Clean(cp.directory)
+	}
+}
+
+// Key returns the project directory: every command typed for the same task shares one load.
+func (cp *CommandProvider) Key(string) string {
+	return cp.directory
+}
+
+// Load runs every detector on dir, dropping duplicate commands.
+func (cp *CommandProvider) Load(ctx context.Context, dir string) ([]string, error) {
+	if dir == "" {
+		return nil, nil
+	}
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return nil, err
+	}
+
+	var commands []string
+	for _, detect := range commandDetectors {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		commands = append(commands, detect(dir)...)
+	}
+	return lo.Uniq(commands), nil
+}
+
+// packageScripts suggests the package.json scripts, run by the package manager whose lockfile is present.
+func packageScripts(dir string) []string {
+	content, err := os.ReadFile(filepath.Join(dir, "package.json"))
+	if err != nil {
+		return nil
+	}
+
+	var manifest struct {
+		Scripts map[string]string `json:"scripts"`
+	}
+	if json.Unmarshal(content, &manifest) != nil {
+		return nil
+	}
+
+	runner := "npm run "
+	switch {
+	case fileExists(dir, "yarn.lock"):
+		runner = "yarn "
+	case fileExists(dir, "pnpm-lock.yaml"):
+		runner = "pnpm "
+	case fileExists(dir, "bun.lockb"), fileExists(dir, "bun.lock"):
+		runner = "bun run "
+	}
+
+	scripts := lo.Keys(manifest.Scripts)
+	slices.Sort(scripts)
+	return lo.Map(scripts, func(script string, _ int) string { return runner + script })
+}
+
+// makeTargetPattern matches a rule line "target: prerequisites", but not a "variable := value" assignment
+var makeTargetPattern = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9_.\-/]*)\s*:(?:[^=]|$)`)
+
+// makeTargets suggests the explicit targets of the Makefile, in file order.
+func makeTargets(dir string) []string {
+	file, found := lo.Find([]string{"GNUmakefile", "makefile", "Makefile"}, func(name string) bool {
+		return fileExists(dir, name)
+	})
+	if !found {
+		return nil
+	}
+
+	var commands []string
+	for _, line := range readLines(filepath.Join(dir, file)) {
+		match := makeTargetPattern.FindStringSubmatch(line)
+		if match == nil || strings.HasPrefix(match[1], ".") {
+			continue
+		}
+		commands = append(commands, "make "+match[1])
+	}
+	return commands
+}
+
+// goMains suggests 'go run' for the main package at the module root and under cmd/.
+func goMains(dir string) []string {
+	if !fileExists(dir, "go.mod") {
+		return nil
+	}
+
+	var commands []string
+	if isMainPackage(dir) {
+		commands = append(commands, "go run .")
+	}
+
+	entries, _ := os.ReadDir(filepath.Join(dir, "cmd"))
+	for _, entry := range entries {
+		if entry.IsDir() && isMainPackage(filepath.Join(dir, "cmd", entry.Name())) {
+			commands = append(commands, "go run ./cmd/"+entry.Name())
+		}
+	}
+	return commands
+}
+
+// isMainPackage reports whether a Go file in dir declares package main.
+func isMainPackage(dir string) bool {
+	files, _ := filepath.Glob(filepath.Join(dir, "*.go"))
+	return lo.SomeBy(files, func(file string) bool {
+		return !strings.HasSuffix(file, "_test.go") && lo.Contains(readLines(file), "package main")
+	})
+}
+
+// cargoCommands suggests the usual cargo commands, and 'cargo run --bin' for every declared binary.
+func cargoCommands(dir string) []string {
+	if !fileExists(dir, "Cargo.toml") {
+		return nil
+	}
+
+	commands := []string{"cargo run", "cargo test", "cargo build --release"}
+
+	inBinSection := false
+	for _, line := range readLines(filepath.Join(dir, "Cargo.toml")) {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "[") {
+			inBinSection = line == "[[bin]]"
+			continue
+		}
+
+		key, value, found := strings.Cut(line, "=")
+		if inBinSection && found && strings.TrimSpace(key) == "name" {
+			commands = append(commands, "cargo run --bin "+strings.Trim(strings.TrimSpace(value), `"'`))
+		}
+	}
+	return commands
+}
+
+// composeCommands suggests starting the docker compose services.
+func composeCommands(dir string) []string {
+	if lo.SomeBy([]string{"compose.yaml", "compose.yml", "docker-compose.yaml", "docker-compose.yml"}, func(name string) bool {
+		return fileExists(dir, name)
+	}) {
+		return []string{"docker compose up"}
+	}
+	return nil
+}
+
+// procfileCommands suggests the command of every Procfile process.
+func procfileCommands(dir string) []string {
+	var commands []string
+	for _, line := range readLines(filepath.Join(dir, "Procfile")) {
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+		if _, command, found := strings.Cut(line, ":"); found && strings.TrimSpace(command) != "" {
+			commands = append(commands, strings.TrimSpace(command))
+		}
+	}
+	return commands
+}
+
+// fileExists reports whether dir holds a regular file called name.
+func fileExists(dir, name string) bool {
+	info, err := os.Stat(filepath.Join(dir, name))
+	return err == nil && !info.IsDir()
+}
+
+// readLines returns the lines of the file at path, none when it cannot be read.
+func readLines(path string) []string {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer file.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return lines
+}
@@ -0,0 +1,102 @@
+package components
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestCommandProvider_Load(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string]string
+		expected []string
+	}{
+		{
+			name:     "npm scripts without a lockfile",
+			files:    map[string]string{"package.json": `{"scripts": {"start": "node .", "dev": "vite"}}`},
+			expected: []string{"npm run dev", "npm run start"},
+		},
+		{
+			name: "yarn scripts with yarn.lock",
+			files: map[string]string{
+				"package.json": `{"scripts": {"dev": "vite"}}`,
+				"yarn.lock":    "",
+			},
+			expected: []string{"yarn dev"},
+		},
+		{
+			name: "pnpm scripts with pnpm-lock.yaml",
+			files: map[string]string{
+				"package.json":   `{"scripts": {"test": "vitest"}}`,
+				"pnpm-lock.yaml": "",
+			},
+			expected: []string{"pnpm test"},
+		},
+		{
+			name:     "Makefile targets skip special targets and assignments",
+			files:    map[string]string{"Makefile": ".PHONY: run\nVERSION := 1.0\nrun: build\n\tgo run .\nbuild:\n\tgo build\n"},
+			expected: []string{"make run", "make build"},
+		},
+		{
+			name: "Go mains at the root and under cmd",
+			files: map[string]string{
+				"go.mod":             "module example.com/app\n",
+				"main.go":            "package main\n",
+				"cmd/api/main.go":    "package main\n",
+				"cmd/shared/util.go": "package shared\n",
+			},
+			expected: []string{"go run .", "go run ./cmd/api"},
+		},
+		{
+			name:     "cargo commands and binaries",
+			files:    map[string]string{"Cargo.toml": "[package]\nname = \"app\"\n\n[[bin]]\nname = \"worker\"\n"},
+			expected: []string{"cargo run", "cargo test", "cargo build --release", "cargo run --bin worker"},
+		},
+		{
+			name: "docker compose and Procfile",
+			files: map[string]string{
+				"compose.yaml": "services: {}\n",
+				"Procfile":     "# processes\nweb: bundle exec rails s\nworker: bundle exec sidekiq\n",
+			},
+			expected: []string{"docker compose up", "bundle exec rails s", "bundle exec sidekiq"},
+		},
+		{
+			name:     "directory without project files",
+			files:    map[string]string{"README.md": "# app\n"},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				path := filepath.Join(dir, name)
+				if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			provider := NewCommandProvider()
+			provider.SetDirectory(dir)
+
+			// Act
+			result, err := provider.Load(context.Background(), provider.Key(""))
+
+			// Assert
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(result, tt.expected) {
+				t.Errorf("expected commands %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/samber/lo"
+)
+
+// UI Layout constants
+const (
+	pickerVisibleRows  = 15 // Icons listed at once, the rest scrolls
+	pickerNameWidth    = 32 // Fixed width of the icon name column
+	pickerSearchLength = 40 // Maximum characters in the search input
+)
+
+// IconPicker is a full-screen, searchable list of every VSCode icon with its description.
+type IconPicker struct {
+	search   textinput.Model
+	filtered []styles.VSCodeIcon
+	cursor   int
+	color    string // Icon color of the task, used to preview the icon names
+}
+
+// NewIconPicker creates an icon picker focused on the current icon, previewing names in color.
+func NewIconPicker(current, color string) *IconPicker {
+	search := textinput.New()
+	search.Placeholder = "Search icons by name or description..."
+	search.CharLimit = pickerSearchLength
+	search.Focus()
+
+	ip := &IconPicker{search: search, filtered: styles.VSCodeIcons, color: color}
+	if _, index, found := lo.FindIndexOf(styles.VSCodeIcons, func(icon styles.VSCodeIcon) bool { return icon.Name == current }); found {
+		ip.cursor = index
+	}
+	return ip
+}
+
+// Selected returns the icon under the cursor, false when nothing matches the search.
+func (ip *IconPicker) Selected() (styles.VSCodeIcon, bool) {
+	if ip.cursor < 0 || ip.cursor >= len(ip.filtered) {
+		return styles.VSCodeIcon{}, false
+	}
+	return ip.filtered[ip.cursor], true
+}
+
+// MoveCursor moves the cursor by offset rows, stopping at both ends of the list.
+func (ip *IconPicker) MoveCursor(offset int) {
+	if len(ip.filtered) == 0 {
+		return
+	}
+	ip.cursor = min(max(ip.cursor+offset, 0), len(ip.filtered)-1)
+}
+
+// PageSize returns the number of rows a page up or down moves.
+func (ip *IconPicker) PageSize() int {
+	return pickerVisibleRows
+}
+
+// Update edits the search and filters the icons. Every word must appear in the name or description.
+func (ip *IconPicker) Update(msg tea.Msg) tea.Cmd {
+	var cmd tea.Cmd
+	previous := ip.search.Value()
+	ip.search, cmd = ip.search.Update(msg)
+
+	if query := ip.search.Value(); query != previous {
+		terms := strings.Fields(strings.ToLower(query))
+		ip.filtered = lo.Filter(styles.VSCodeIcons, func(icon styles.VSCodeIcon, _ int) bool {
+			text := strings.ToLower(icon.Name + " " + icon.Desc)
+			return lo.EveryBy(terms, func(term string) bool { return strings.Contains(text, term) })
+		})
+		ip.cursor = 0
+	}
+
+	return cmd
+}
+
+// View renders the search box, the visible icons and the key help.
+func (ip *IconPicker) View() string {
+	sections := []string{
+		styles.RenderTitle("PICK AN ICON"),
+		styles.TextInputStyle.Render(ip.search.View()),
+		ip.renderList(),
+		styles.LightGrayStyle.Render(fmt.Sprintf("%d/%d icons", min(ip.cursor+1, len(ip.filtered)), len(ip.filtered))),
+		styles.HelpTextStyle.Render("↑/↓ navigate • pgup/pgdown page • enter select • esc cancel"),
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+// renderList renders the page of icons around the cursor.
+func (ip *IconPicker) renderList() string {
+	if len(ip.filtered) == 0 {
+		return styles.LightGrayStyle.Render("No icons match your search.")
+	}
+
+	start := 0
+	if ip.cursor >= pickerVisibleRows {
+		start = ip.cursor - pickerVisibleRows + 1
+	}
+	end := min(start+pickerVisibleRows, len(ip.filtered))
+
+	nameStyle := lipgloss.NewStyle()
+	if color, found := styles.FindANSIColor(ip.color); found {
+		nameStyle = nameStyle.Foreground(color.Color)
+	}
+
+	rows := make([]string, 0, end-start)
+	for i := start; i < end; i++ {
+		icon := ip.filtered[i]
+		name := nameStyle.Render(fmt.Sprintf("%-*s", pickerNameWidth, icon.Name))
+
+		if i == ip.cursor {
+			rows = append(rows, styles.FocusedTaskStyle.Render("▶ ")+name+styles.FocusedTaskStyle.Render(icon.Desc))
+			continue
+		}
+		rows = append(rows, "  "+name+styles.LightGrayStyle.Render(icon.Desc))
+	}
+
+	return strings.Join(rows, "\n")
+}
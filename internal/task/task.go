@@ -3,7 +3,10 @@ package task
 import (
 	"strings"
 
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/cfg"
 	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/repository"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/task/components"
 	"github.com/DieGopherLT/vscode-terminal-runner/pkg/messages"
 	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
 	"github.com/DieGopherLT/vscode-terminal-runner/pkg/tui"
@@ -22,13 +25,19 @@ var (
 // TaskModel manages the state and logic of the TUI form for creating/editing tasks.
 type TaskModel struct {
 	nav                *tui.FormNavigator
-	inputs             []textinput.Model
+	inputs             []textinput.Model // The commands field is edited by commandEditor, its input stays unused
+	commandEditor      *components.CommandEditor
+	iconPicker         *components.IconPicker // Open icon picker, nil when closed
 	iconSuggestions    *suggestions.Manager
 	colorSuggestions   *suggestions.Manager
 	pathSuggestions    *suggestions.PathManager
+	commandSuggestions *suggestions.Manager
+	commandProvider    *components.CommandProvider // Mines the commands of the project at the task path
 	messages           *messages.MessageManager
 	isEditMode         bool
 	originalTaskName   string
+	recordHistory      bool // Whether saving records the task values in the usage history
+	validator          *tui.FormValidator
 }
 
 // NewModel initializes and returns the TUI model for the task creation form.
@@ -43,7 +52,7 @@ func NewEditModel(task *models.Task) tea.Model {
 
 // newModelInternal creates a task form model, optionally pre-filled with existing task data.
 func newModelInternal(existingTask *models.Task) tea.Model {
-	numberOfFields := 5
+	numberOfFields := 7
 
 	// Create suggestion managers
 	iconNames := lo.Map(styles.VSCodeIcons, func(i styles.VSCodeIcon, _ int) string { return i.Name })
@@ -51,18 +60,45 @@ func newModelInternal(existingTask *models.Task) tea.Model {
 
 	model := &TaskModel{
 		inputs:           make([]textinput.Model, numberOfFields),
+		commandEditor:    components.NewCommandEditor(nil),
 		nav:              tui.NewNavigator(numberOfFields),
-		iconSuggestions:  suggestions.NewManager(iconNames, 3, suggestions.ContainsFilter),
-		colorSuggestions: suggestions.NewManager(colorNames, 3, suggestions.ContainsFilter),
+		iconSuggestions:  suggestions.NewScoringManager(iconNames, 3, suggestions.FuzzyScore),
+		colorSuggestions: suggestions.NewScoringManager(colorNames, 3, suggestions.FuzzyScore),
 		pathSuggestions:  suggestions.NewPathManager(5),
+		commandProvider:  components.NewCommandProvider(),
 		messages:         messages.NewManager(),
 		isEditMode:       existingTask != nil,
 		originalTaskName: "",
 	}
 
+	model.commandSuggestions = suggestions.NewProviderManager(model.commandProvider, 5, suggestions.ContainsFilter, true)
+
+	model.iconSuggestions.SetDescribeFunc(func(name string) string {
+		icon, _ := styles.FindIcon(name)
+		return styles.LightGrayStyle.Render(icon.Desc)
+	})
+	model.colorSuggestions.SetDescribeFunc(func(name string) string {
+		color, _ := styles.FindANSIColor(name)
+		return styles.RenderColorSwatch(name) + " " + styles.LightGrayStyle.Render(color.Desc)
+	})
+
 	// If editing, store the original name
 	if existingTask != nil {
 		model.originalTaskName = existingTask.Name
+		model.commandEditor = components.NewCommandEditor(existingTask.Cmds)
+	}
+
+	config := cfg.LoadOrDefault()
+	otherTasks := otherTasks(model.originalTaskName)
+	model.pathSuggestions.SetSources(suggestions.PathSources{
+		SearchRoots:  config.PathSearchRoots,
+		Bookmarks:    config.PathBookmarks,
+		TaskPaths:    lo.Uniq(lo.Map(otherTasks, func(task models.Task, _ int) string { return task.Path })),
+		IncludeFiles: config.PathSuggestFiles,
+	})
+	if config.SuggestionHistory {
+		model.recordHistory = true
+		model.applyUsageHistory()
 	}
 
 	for i := range model.inputs {
@@ -81,29 +117,50 @@ func newModelInternal(existingTask *models.Task) tea.Model {
 			t.Focus()
 			t.PromptStyle = styles.FocusedInputStyle
 			t.TextStyle = styles.FocusedInputStyle
+		case descriptionField:
+			t.Placeholder = "Optional, e.g., REST API with hot reload"
+			if existingTask != nil {
+				t.SetValue(existingTask.Description)
+			}
 		case pathField:
 			t.Placeholder = "e.g., /home/user/project, absolute path or relative cwd"
 			if existingTask != nil {
 				t.SetValue(existingTask.Path)
 			}
-		case cmdsField:
-			t.Placeholder = "cmd1, cmd2... (e.g., yarn install, yarn dev)"
+		case tagsField:
+			t.Placeholder = "Optional, tag1, tag2... (e.g., backend, go)"
 			if existingTask != nil {
-				t.SetValue(strings.Join(existingTask.Cmds, ", "))
+				t.SetValue(strings.Join(existingTask.Tags, ", "))
 			}
 		case iconField:
 			t.Placeholder = "e.g., terminal-bash"
 			if existingTask != nil {
 				t.SetValue(existingTask.Icon)
+			} else {
+				t.SetValue(config.DefaultIcon)
 			}
 		case iconColorField:
 			t.Placeholder = "terminal.<color> (e.g., terminal.ansiGreen)"
 			if existingTask != nil {
 				t.SetValue(existingTask.IconColor)
+			} else {
+				t.SetValue(config.DefaultIconColor)
 			}
 		}
 		model.inputs[i] = t
 	}
 
+	model.registerValidators(otherTasks)
+
 	return model
-}
\ No newline at end of file
+}
+
+// otherTasks returns the saved tasks except the one being edited, for the path suggestions and the name check.
+func otherTasks(editedTaskName string) []models.Task {
+	tasks, err := repository.ReadTasks()
+	if err != nil {
+		return nil
+	}
+
+	return lo.Reject(tasks, func(task models.Task, _ int) bool { return task.Name == editedTaskName })
+}
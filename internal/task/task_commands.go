@@ -1,11 +1,13 @@
 package task
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/completion"
 	"github.com/DieGopherLT/vscode-terminal-runner/internal/repository"
-	"github.com/DieGopherLT/vscode-terminal-runner/internal/vscode"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/output"
 	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
@@ -15,21 +17,31 @@ import (
 var CreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a new task",
-	Long:  `Create a new task with the specified configuration`,
+	Long: `Create a new task with the specified configuration.
+Without flags an interactive form is opened; pass --name, --path, --cmd and optionally
+--description, --tag, --icon and --color to create the task non-interactively.`,
+	Example: `  vstr task create --name api --path ~/src/api --cmd "go mod download" --cmd "go run ."`,
 	Run: func(cmd *cobra.Command, args []string) {
 
 		batchPath, _ := cmd.Flags().GetString("file")
 		if batchPath != "" {
-			err := repository.SaveFromFile(batchPath) 
-			if err != nil {
+			onConflict, _ := cmd.Flags().GetString("on-conflict")
+			if err := importTasksFromFile(batchPath, onConflict); err != nil {
 				styles.PrintError(fmt.Sprintf("Failed to create tasks from file: %v", err))
 				os.Exit(1)
 			}
-			styles.PrintSuccess("Tasks created successfully from file!")
 
 			os.Exit(0)	
 		}
 
+		if hasTaskFieldFlags(cmd) {
+			if err := createTaskFromFlags(cmd); err != nil {
+				styles.PrintError(fmt.Sprintf("Failed to create task: %v", err))
+				os.Exit(1)
+			}
+			return
+		}
+
 		p := tea.NewProgram(NewModel())
 		if _, err := p.Run(); err != nil {
 			os.Exit(1)
@@ -41,33 +53,58 @@ var CreateCmd = &cobra.Command{
 var ListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all tasks",
-	Long:  `Display a list of all configured tasks`,
+	Long: `Display a list of all configured tasks.
+Filter with --tag (any of the given tags) and --search (text in name, description or path,
+or #tag). Use --output json|yaml|names for scriptable output (see docs/OUTPUT.md).`,
 	Run: func(cmd *cobra.Command, args []string) {
-		onlyNames, _ := cmd.Flags().GetBool("only-names")
+		if onlyNames, _ := cmd.Flags().GetBool("only-names"); onlyNames {
+			output.SetFormat(output.Names)
+		}
 
-		if onlyNames {
-			err := listAllTaskNames()
-			if err != nil {
-				fmt.Println("Error listing task names:", err)
-			}
-			return
+		tags, _ := cmd.Flags().GetStringArray("tag")
+		search, _ := cmd.Flags().GetString("search")
+
+		if err := listAllTasks(tags, search); err != nil {
+			styles.PrintError(fmt.Sprintf("Error listing tasks: %v", err))
+			os.Exit(1)
 		}
+	},
+}
 
-		if err := listAllTasks(); err != nil {
-			fmt.Println("Error listing tasks:", err)
+// ShowCmd prints the details of a task.
+var ShowCmd = &cobra.Command{
+	Use:   "show <name>",
+	Short: "Show a task",
+	Long:  `Display the path, commands and icon of a task`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := showTask(args[0]); err != nil {
+			styles.PrintError(fmt.Sprintf("Failed to show task: %v", err))
+			os.Exit(1)
 		}
 	},
 }
 
-// DeleteCmd deletes a task specified by name.
+// DeleteCmd deletes the tasks matching the given names or glob patterns.
 var DeleteCmd = &cobra.Command{
-	Use:   "delete <name>",
-	Short: "Delete a task",
-	Long:  `Delete a task with the specified name`,
-	Args:  cobra.ExactArgs(1),
+	Use:   "delete <name|glob>...",
+	Short: "Delete tasks",
+	Long: `Delete one or more tasks by name or glob pattern (e.g. 'api-*').
+Workspaces containing the tasks are listed before anything is deleted.`,
+	Args: cobra.MinimumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		taskName := args[0]
-		fmt.Println("Deleting task:", taskName)
+		skipConfirmation, _ := cmd.Flags().GetBool("yes")
+		force, _ := cmd.Flags().GetBool("force")
+
+		err := deleteTasks(args, skipConfirmation, force)
+		if errors.Is(err, errDeleteAborted) {
+			styles.PrintInfo("Aborted.")
+			return
+		}
+		if err != nil {
+			styles.PrintError(fmt.Sprintf("Failed to delete tasks: %v", err))
+			os.Exit(1)
+		}
 	},
 }
 
@@ -95,31 +132,63 @@ var EditCmd = &cobra.Command{
 	},
 }
 
-var RunCmd = &cobra.Command{
-	Use:   "run <name>",
-	Short: "Run a task",
-	Long:  `Run a task with the specified name`,
-	Args:  cobra.ExactArgs(1),
+// SetCmd updates individual fields of a task without the TUI form.
+var SetCmd = &cobra.Command{
+	Use:   "set <name>",
+	Short: "Update fields of a task",
+	Long: `Update fields of an existing task without opening the form.
+--cmd replaces all commands, --add-cmd appends to them. Both can be repeated.`,
+	Example: `  vstr task set api --add-cmd "go test ./..."
+  vstr task set api --path ~/src/api-v2 --color terminal.ansiBlue`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		taskName := args[0]
+		if err := setTaskFromFlags(cmd, args[0]); err != nil {
+			styles.PrintError(fmt.Sprintf("Failed to update task: %v", err))
+			os.Exit(1)
+		}
+	},
+}
 
-		runner, err := vscode.NewSecureRunner()
-		if err != nil {
-			styles.PrintError(fmt.Sprintf("Failed to create secure runner: %v", err))
-			return	
+// RenameCmd renames a task and every workspace copy of it.
+var RenameCmd = &cobra.Command{
+	Use:   "rename <old> <new>",
+	Short: "Rename a task",
+	Long:  `Rename a task. Workspaces containing the task are updated to the new name.`,
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := repository.RenameTask(args[0], args[1]); err != nil {
+			styles.PrintError(fmt.Sprintf("Failed to rename task: %v", err))
+			os.Exit(1)
 		}
+		styles.PrintSuccess(fmt.Sprintf("Task '%s' renamed to '%s'", args[0], args[1]))
+	},
+}
 
-		styles.PrintProgress(fmt.Sprintf("Detected secure VSCode instance, proceeding to run task '%s'...", taskName))
+// RunCmd runs one or more tasks, selected by name, glob pattern or tag.
+var RunCmd = &cobra.Command{
+	Use:   "run [name|glob]...",
+	Short: "Run tasks",
+	Long: `Run one or more tasks selected by name, glob pattern (e.g. 'api-*') or --tag.
+Several tasks are launched together as an ad-hoc workspace over a single bridge connection.`,
+	Example: `  vstr task run api
+  vstr task run api web 'worker-*'
+  vstr task run --tag backend`,
+	Run: func(cmd *cobra.Command, args []string) {
+		tags, _ := cmd.Flags().GetStringArray("tag")
 
-		if err := runner.RunTask(taskName); err != nil {
-			styles.PrintError(fmt.Sprintf("Error running task: %v", err))
-			return
+		if err := runTasks(args, tags); err != nil {
+			styles.PrintError(fmt.Sprintf("Error running tasks: %v", err))
+			os.Exit(1)
 		}
 	},
 }
 
 func init() {
-	ListCmd.Flags().BoolP("only-names", "n", false, "List only task names")
+	ListCmd.Flags().BoolP("only-names", "n", false, "List only task names (same as --output names)")
+	ListCmd.Flags().StringArrayP("tag", "t", nil, "Only list tasks with this tag (repeatable)")
+	ListCmd.Flags().StringP("search", "s", "", "Only list tasks matching the query, e.g. 'api #backend'")
+	DeleteCmd.Flags().BoolP("yes", "y", false, "Delete without asking for confirmation")
+	DeleteCmd.Flags().BoolP("force", "f", false, "Also remove the tasks from every workspace that contains them, without asking")
 	
 	fileHelpText := "Creates tasks from a JSON file\n\n" +
 		"Example JSON format:\n" +
@@ -128,7 +197,8 @@ func init() {
 		"    \"name\": \"Build Project\",\n" +
 		"    \"icon\": \"tools\",\n" +
 		"    \"iconColor\": \"terminal.ansiBlue\",\n" +
-		"    \"cmds\": [\"npm run build\", \"echo Build completed\"]\n" +
+		"    \"cmds\": [\"npm run build\", \"echo Build completed\"],\n" +
+		"    \"tags\": [\"frontend\"]\n" +
 		"  },\n" +
 		"  {\n" +
 		"    \"name\": \"Run Tests\",\n" +
@@ -139,4 +209,36 @@ func init() {
 		"]"
 	
 	CreateCmd.Flags().StringP("file", "f", "", fileHelpText)
+	CreateCmd.Flags().String("on-conflict", string(repository.ConflictFail), "What to do with tasks from --file whose name is taken: skip, overwrite, rename or fail")
+	CreateCmd.Flags().String("name", "", "Task name")
+	CreateCmd.Flags().String("description", "", "Short explanation of the task")
+	CreateCmd.Flags().String("path", "", "Directory where the commands run")
+	CreateCmd.Flags().StringArray("cmd", nil, "Command to run (repeatable, runs in order)")
+	CreateCmd.Flags().String("icon", "", "VSCode icon name (defaults to the configured default_icon)")
+	CreateCmd.Flags().String("color", "", "Terminal icon color (defaults to the configured default_icon_color)")
+
+	SetCmd.Flags().String("description", "", "New description")
+	SetCmd.Flags().String("path", "", "New directory where the commands run")
+	SetCmd.Flags().StringArray("cmd", nil, "Replace all commands (repeatable)")
+	SetCmd.Flags().StringArray("add-cmd", nil, "Append a command (repeatable)")
+	SetCmd.Flags().String("icon", "", "New VSCode icon name")
+	SetCmd.Flags().String("color", "", "New terminal icon color")
+
+	RunCmd.Flags().StringArrayP("tag", "t", nil, "Run every task with this tag (repeatable)")
+	CreateCmd.Flags().StringArray("tag", nil, "Tag used to select the task, e.g. backend (repeatable)")
+	SetCmd.Flags().StringArray("tag", nil, "Replace all tags (repeatable)")
+	SetCmd.Flags().StringArray("add-tag", nil, "Add a tag (repeatable)")
+
+	// Shell completion for saved names
+	RunCmd.ValidArgsFunction = completion.TaskNamesMulti
+	RunCmd.RegisterFlagCompletionFunc("tag", completion.TagNames)
+	EditCmd.ValidArgsFunction = completion.TaskNames
+	ShowCmd.ValidArgsFunction = completion.TaskNames
+	SetCmd.ValidArgsFunction = completion.TaskNames
+	RenameCmd.ValidArgsFunction = completion.TaskNames
+	ListCmd.RegisterFlagCompletionFunc("tag", completion.TagNames)
+	CreateCmd.RegisterFlagCompletionFunc("tag", completion.TagNames)
+	SetCmd.RegisterFlagCompletionFunc("tag", completion.TagNames)
+	SetCmd.RegisterFlagCompletionFunc("add-tag", completion.TagNames)
+	DeleteCmd.ValidArgsFunction = completion.TaskNamesMulti
 }
\ No newline at end of file
@@ -0,0 +1,97 @@
+package task
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/repository"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/prompt"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
+	"github.com/samber/lo"
+)
+
+// errDeleteAborted is returned when the user declines the deletion.
+var errDeleteAborted = errors.New("deletion aborted")
+
+// deleteImpact lists the workspaces that embed a task about to be deleted.
+type deleteImpact struct {
+	task       models.Task
+	workspaces []string
+}
+
+// deleteTasks resolves names and globs, shows which workspaces are affected and deletes the tasks.
+// skipConfirmation answers the confirmation prompt; force also removes the tasks from the
+// workspaces that contain them without asking.
+func deleteTasks(patterns []string, skipConfirmation, force bool) error {
+	tasks, err := repository.MatchTasks(patterns)
+	if err != nil {
+		return err
+	}
+
+	impacts, err := analyzeDeleteImpact(tasks)
+	if err != nil {
+		return err
+	}
+
+	printDeleteImpact(impacts)
+
+	isReferenced := lo.SomeBy(impacts, func(impact deleteImpact) bool {
+		return len(impact.workspaces) > 0
+	})
+
+	switch {
+	case force:
+	case isReferenced && skipConfirmation:
+		return fmt.Errorf("some tasks are used by workspaces; re-run with --force to remove them from those workspaces")
+	case isReferenced:
+		if !prompt.Confirm("Remove these tasks from their workspaces and delete them?") {
+			return errDeleteAborted
+		}
+	case !skipConfirmation:
+		if !prompt.Confirm(fmt.Sprintf("Delete %d task(s)?", len(tasks))) {
+			return errDeleteAborted
+		}
+	}
+
+	names := lo.Map(tasks, func(task models.Task, _ int) string { return task.Name })
+	if err := repository.DeleteTasks(names, isReferenced); err != nil {
+		return err
+	}
+
+	styles.PrintSuccess(fmt.Sprintf("Deleted %d task(s): %s", len(names), strings.Join(names, ", ")))
+	return nil
+}
+
+// analyzeDeleteImpact finds the workspaces affected by deleting each task.
+func analyzeDeleteImpact(tasks []models.Task) ([]deleteImpact, error) {
+	impacts := make([]deleteImpact, 0, len(tasks))
+
+	for _, task := range tasks {
+		workspaces, err := repository.FindWorkspacesWithTask(task.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		impacts = append(impacts, deleteImpact{
+			task:       task,
+			workspaces: lo.Map(workspaces, func(ws models.Workspace, _ int) string { return ws.Name }),
+		})
+	}
+
+	return impacts, nil
+}
+
+// printDeleteImpact shows the tasks to delete and the workspaces that contain them.
+func printDeleteImpact(impacts []deleteImpact) {
+	fmt.Println(styles.RunnerHeaderStyle.Render("TASKS TO DELETE"))
+
+	for _, impact := range impacts {
+		fmt.Printf("  %s %s\n", impact.task.Icon, styles.RunnerTaskNameStyle.Render(impact.task.Name))
+		if len(impact.workspaces) > 0 {
+			fmt.Println(styles.RunnerWarningStyle.Render(fmt.Sprintf("    used by workspaces: %s", strings.Join(impact.workspaces, ", "))))
+		}
+	}
+	fmt.Println()
+}
@@ -0,0 +1,53 @@
+package task
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/paths"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/repository"
+)
+
+func TestAnalyzeDeleteImpact(t *testing.T) {
+	// Arrange
+	t.Setenv(paths.ConfigDirEnv, t.TempDir())
+	t.Setenv(paths.ProfileEnv, "")
+
+	api := models.Task{Name: "api", Cmds: []string{"go run ."}}
+	web := models.Task{Name: "web", Cmds: []string{"npm start"}}
+	worker := models.Task{Name: "worker", Cmds: []string{"go run ./cmd/worker"}}
+	for _, workspace := range []models.Workspace{
+		{Name: "backend", Tasks: []models.Task{api, worker}},
+		{Name: "full-stack", Tasks: []models.Task{api, web}},
+	} {
+		if err := repository.SaveWorkspace(workspace); err != nil {
+			t.Fatalf("failed to save workspace: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name               string
+		task               models.Task
+		expectedWorkspaces []string
+	}{
+		{name: "task used by several workspaces", task: api, expectedWorkspaces: []string{"backend", "full-stack"}},
+		{name: "task used by one workspace", task: web, expectedWorkspaces: []string{"full-stack"}},
+		{name: "task used by no workspace", task: models.Task{Name: "docs"}, expectedWorkspaces: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			impacts, err := analyzeDeleteImpact([]models.Task{tt.task})
+
+			// Assert
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(impacts) != 1 || !slices.Equal(impacts[0].workspaces, tt.expectedWorkspaces) {
+				t.Errorf("expected workspaces %v, got %+v", tt.expectedWorkspaces, impacts)
+			}
+		})
+	}
+}
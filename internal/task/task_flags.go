@@ -0,0 +1,139 @@
+package task
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/cfg"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/repository"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+)
+
+// taskFieldFlags lists the flags that describe a task without the TUI form.
+var taskFieldFlags = []string{"name", "description", "path", "cmd", "icon", "color", "tag"}
+
+// hasTaskFieldFlags reports whether any task field was passed as a flag.
+func hasTaskFieldFlags(cmd *cobra.Command) bool {
+	return lo.SomeBy(taskFieldFlags, func(flag string) bool {
+		return cmd.Flags().Changed(flag)
+	})
+}
+
+// createTaskFromFlags builds a task from the create flags, validates it and saves it.
+// Icon and color fall back to the configured defaults.
+func createTaskFromFlags(cmd *cobra.Command) error {
+	config := cfg.LoadOrDefault()
+
+	name, _ := cmd.Flags().GetString("name")
+	description, _ := cmd.Flags().GetString("description")
+	path, _ := cmd.Flags().GetString("path")
+	cmds, _ := cmd.Flags().GetStringArray("cmd")
+	icon, _ := cmd.Flags().GetString("icon")
+	color, _ := cmd.Flags().GetString("color")
+	tags, _ := cmd.Flags().GetStringArray("tag")
+
+	task := models.Task{
+		Name:        strings.TrimSpace(name),
+		Description: strings.TrimSpace(description),
+		Path:        strings.TrimSpace(path),
+		Cmds:        cleanCommands(cmds),
+		Icon:        lo.Ternary(icon != "", icon, config.DefaultIcon),
+		IconColor:   lo.Ternary(color != "", color, config.DefaultIconColor),
+		Tags:        cleanTags(tags),
+	}
+
+	if err := validateTaskFlags(task); err != nil {
+		return err
+	}
+
+	if err := repository.SaveTask(task); err != nil {
+		return err
+	}
+	recordUsage(config.SuggestionHistory, task)
+
+	styles.PrintSuccess(fmt.Sprintf("Task '%s' created", task.Name))
+	return nil
+}
+
+// setTaskFromFlags applies the changed set flags to an existing task, validates it and saves it.
+func setTaskFromFlags(cmd *cobra.Command, name string) error {
+	flags := cmd.Flags()
+	if !lo.SomeBy([]string{"description", "path", "cmd", "add-cmd", "icon", "color", "tag", "add-tag"}, flags.Changed) {
+		return errors.New("nothing to update; pass at least one of --description, --path, --cmd, --add-cmd, --icon, --color, --tag or --add-tag")
+	}
+
+	existing, err := repository.FindTaskByName(name)
+	if err != nil {
+		return err
+	}
+
+	task := *existing
+
+	if flags.Changed("description") {
+		task.Description, _ = flags.GetString("description")
+		task.Description = strings.TrimSpace(task.Description)
+	}
+	if flags.Changed("path") {
+		task.Path, _ = flags.GetString("path")
+		task.Path = strings.TrimSpace(task.Path)
+	}
+	if flags.Changed("cmd") {
+		cmds, _ := flags.GetStringArray("cmd")
+		task.Cmds = cleanCommands(cmds)
+	}
+	if flags.Changed("add-cmd") {
+		cmds, _ := flags.GetStringArray("add-cmd")
+		task.Cmds = append(task.Cmds, cleanCommands(cmds)...)
+	}
+	if flags.Changed("icon") {
+		task.Icon, _ = flags.GetString("icon")
+	}
+	if flags.Changed("color") {
+		task.IconColor, _ = flags.GetString("color")
+	}
+	if flags.Changed("tag") {
+		tags, _ := flags.GetStringArray("tag")
+		task.Tags = cleanTags(tags)
+	}
+	if flags.Changed("add-tag") {
+		tags, _ := flags.GetStringArray("add-tag")
+		task.Tags = cleanTags(append(task.Tags, tags...))
+	}
+
+	if err := validateTaskFlags(task); err != nil {
+		return err
+	}
+
+	if err := repository.UpdateTask(existing.Name, task); err != nil {
+		return err
+	}
+	recordUsage(cfg.LoadOrDefault().SuggestionHistory, task)
+
+	styles.PrintSuccess(fmt.Sprintf("Task '%s' updated", task.Name))
+	return nil
+}
+
+// validateTaskFlags runs ValidateTask and folds the problems into a single error.
+func validateTaskFlags(task models.Task) error {
+	problems := ValidateTask(task)
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid task: %s", strings.Join(problems, "; "))
+}
+
+// cleanCommands trims the commands and drops the empty ones.
+func cleanCommands(cmds []string) []string {
+	trimmed := lo.Map(cmds, func(c string, _ int) string { return strings.TrimSpace(c) })
+	return lo.Compact(trimmed)
+}
+
+// cleanTags trims the tags, drops the empty ones and removes case-insensitive duplicates.
+func cleanTags(tags []string) []string {
+	trimmed := lo.Compact(lo.Map(tags, func(tag string, _ int) string { return strings.TrimSpace(tag) }))
+	return lo.UniqBy(trimmed, strings.ToLower)
+}
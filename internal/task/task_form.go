@@ -1,6 +1,10 @@
 package task
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/task/components"
 	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
 	"github.com/DieGopherLT/vscode-terminal-runner/pkg/tui"
 	"github.com/DieGopherLT/vscode-terminal-runner/pkg/tui/suggestions"
@@ -10,11 +14,13 @@ import (
 )
 
 const (
-	nameField      = 0 // Name field index
-	pathField      = 1 // Path field index
-	cmdsField      = 2 // Commands field index
-	iconField      = 3 // Icon field index
-	iconColorField = 4 // Icon color field index
+	nameField        = 0 // Name field index
+	descriptionField = 1 // Description field index
+	pathField        = 2 // Path field index
+	cmdsField        = 3 // Commands field index
+	tagsField        = 4 // Tags field index
+	iconField        = 5 // Icon field index
+	iconColorField   = 6 // Icon color field index
 )
 
 // Init initializes the TUI model (cursor blinking).
@@ -25,31 +31,66 @@ func (t *TaskModel) Init() tea.Cmd {
 // Update handles messages received by the TUI model and updates the form state.
 func (t *TaskModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
+	if loaded, ok := msg.(suggestions.LoadedMsg); ok {
+		t.pathSuggestions.Update(loaded)
+		t.iconSuggestions.Update(loaded)
+		t.colorSuggestions.Update(loaded)
+		t.commandSuggestions.Update(loaded)
+		return t, nil
+	}
+
+	if t.validator.Update(msg, t.fieldValue) {
+		return t, nil
+	}
+
+	if t.iconPicker != nil {
+		return t, t.updateIconPicker(msg)
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if t.nav.FocusIndex == cmdsField {
+			// Enter applies a visible suggestion before it adds a new command
+			if msg.String() == "enter" {
+				if cmd, applied := t.applySuggestion(); applied {
+					return t, cmd
+				}
+			}
+			if t.handleCommandEditorKey(msg.String()) {
+				return t, tea.Batch(t.commandSuggestions.Query(t.commandEditor.FocusedCommand()), t.validator.Changed(cmdsField))
+			}
+		}
+
 		switch msg.String() {
 		case "ctrl+c", "esc":
 			return t, tea.Quit
 
+		case "ctrl+o":
+			// ctrl+i would be indistinguishable from tab in most terminals
+			t.iconPicker = components.NewIconPicker(t.inputs[iconField].Value(), t.inputs[iconColorField].Value())
+			return t, textinput.Blink
+
 		case string(tui.KeyUp), string(tui.KeyDown), string(tui.KeyTab), string(tui.KeyShiftTab):
 			key := msg.String()
 			
 			// If there are suggestions and Tab pressed, apply suggestion
 			if key == string(tui.KeyTab) {
-				if t.nav.FocusIndex == pathField && t.pathSuggestions.ShouldShow(t.inputs[t.nav.FocusIndex].Value()) {
-					t.pathSuggestions.ApplySelected(&t.inputs[t.nav.FocusIndex])
-					return t, nil
-				} else if manager := t.getCurrentSuggestionManager(); manager != nil && manager.ShouldShow(t.inputs[t.nav.FocusIndex].Value()) {
-					manager.ApplySelected(&t.inputs[t.nav.FocusIndex])
-					return t, nil
+				if cmd, applied := t.applySuggestion(); applied {
+					return t, cmd
 				}
 			}
 			
+			// Validate the field being left
+			if t.nav.FocusIndex < len(t.inputs) {
+				t.validator.Validate(t.nav.FocusIndex, t.fieldValue(t.nav.FocusIndex))
+			}
+
 			t.nav.HandleNavigation(key)
 			// Reset suggestion managers when navigating between fields
 			t.iconSuggestions.Reset()
 			t.colorSuggestions.Reset()
 			t.pathSuggestions.Reset()
+			t.commandSuggestions.Reset()
 			return t.HandleFocus()
 
 		case "ctrl+n":
@@ -72,17 +113,18 @@ func (t *TaskModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		case "enter":
 			// If there are suggestions, apply the selected one
-			if t.nav.FocusIndex == pathField && t.pathSuggestions.ShouldShow(t.inputs[t.nav.FocusIndex].Value()) {
-				t.pathSuggestions.ApplySelected(&t.inputs[t.nav.FocusIndex])
-				return t, nil
-			} else if manager := t.getCurrentSuggestionManager(); manager != nil && manager.ShouldShow(t.inputs[t.nav.FocusIndex].Value()) {
-				manager.ApplySelected(&t.inputs[t.nav.FocusIndex])
-				return t, nil
+			if cmd, applied := t.applySuggestion(); applied {
+				return t, cmd
 			}
 			
 			if t.nav.FocusIndex != len(t.inputs) {
 				return t, nil
 			}
+
+			// Submit stays disabled until every field is valid, the problems show under their fields
+			if !t.validator.ValidateAll(t.fieldValue) {
+				return t, nil
+			}
 			task := t.handleTaskCreation()
 
 			if !t.isValidTask(task) {
@@ -90,8 +132,10 @@ func (t *TaskModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 
 			if err := t.saveTask(task); err != nil {
-				return t, tea.Quit
+				t.messages.AddError(fmt.Sprintf("Failed to save task: %v", err))
+				return t, nil
 			}
+			recordUsage(t.recordHistory, task)
 			
 			successMessage := "Task created successfully!"
 			if t.isEditMode {
@@ -108,11 +152,78 @@ func (t *TaskModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return t, cmd
 }
 
+// updateIconPicker forwards a message to the open icon picker.
+// Enter copies the chosen icon into the icon field, esc closes the picker without changes.
+func (t *TaskModel) updateIconPicker(msg tea.Msg) tea.Cmd {
+	keyMsg, isKey := msg.(tea.KeyMsg)
+	if !isKey {
+		return t.iconPicker.Update(msg)
+	}
+
+	switch keyMsg.String() {
+	case "ctrl+c":
+		return tea.Quit
+	case "esc":
+		t.iconPicker = nil
+	case "enter":
+		if icon, found := t.iconPicker.Selected(); found {
+			t.inputs[iconField].SetValue(icon.Name)
+			t.inputs[iconField].CursorEnd()
+			t.iconSuggestions.UpdateFilter(icon.Name)
+			t.validator.Validate(iconField, icon.Name)
+		}
+		t.iconPicker = nil
+	case string(tui.KeyUp):
+		t.iconPicker.MoveCursor(-1)
+	case string(tui.KeyDown):
+		t.iconPicker.MoveCursor(1)
+	case "pgup":
+		t.iconPicker.MoveCursor(-t.iconPicker.PageSize())
+	case "pgdown":
+		t.iconPicker.MoveCursor(t.iconPicker.PageSize())
+	default:
+		return t.iconPicker.Update(msg)
+	}
+	return nil
+}
+
+// handleCommandEditorKey applies the list keys of the commands field, reporting whether the key was used.
+// Up and down leave the field once they reach the first or last command.
+func (t *TaskModel) handleCommandEditorKey(key string) bool {
+	switch key {
+	case string(tui.KeyUp):
+		return t.commandEditor.MoveFocus(-1)
+	case string(tui.KeyDown):
+		return t.commandEditor.MoveFocus(1)
+	case "enter":
+		t.commandEditor.AddCommand()
+	case "ctrl+x":
+		t.commandEditor.RemoveFocused()
+	case "shift+up":
+		t.commandEditor.MoveCommand(-1)
+	case "shift+down":
+		t.commandEditor.MoveCommand(1)
+	default:
+		return false
+	}
+	return true
+}
+
 // HandleFocus updates the visual focus and style of the form fields.
 func (t *TaskModel) HandleFocus() (tea.Model, tea.Cmd) {
 	cmds := make([]tea.Cmd, len(t.inputs))
 
 	for i := 0; i < len(t.inputs); i++ {
+		if i == cmdsField {
+			if i == t.nav.FocusIndex {
+				// Commands are mined from the project at the path entered so far
+				t.commandProvider.SetDirectory(expandPathForValidation(strings.TrimSpace(t.inputs[pathField].Value())))
+				cmds[i] = tea.Batch(t.commandEditor.Focus(), t.commandSuggestions.Query(t.commandEditor.FocusedCommand()))
+			} else {
+				t.commandEditor.Blur()
+			}
+			continue
+		}
 
 		if i == t.nav.FocusIndex {
 			cmds[i] = t.inputs[i].Focus()
@@ -131,36 +242,53 @@ func (t *TaskModel) HandleFocus() (tea.Model, tea.Cmd) {
 
 // HandleInput processes text input and updates the suggestion managers.
 func (t *TaskModel) HandleInput(msg tea.Msg) tea.Cmd {
-	cmds := make([]tea.Cmd, len(t.inputs))
+	cmds := make([]tea.Cmd, 0, len(t.inputs)+1)
 
 	// Clear messages when user starts typing
 	if t.messages.HasMessages() {
 		t.messages.Clear()
 	}
 
-	for i := range t.inputs {
-		t.inputs[i], cmds[i] = t.inputs[i].Update(msg)
+	focused := t.nav.FocusIndex
+	before := t.fieldValue(focused)
 
-		// Update suggestion managers based on input changes
-		if i == pathField && i == t.nav.FocusIndex {
-			// For path suggestions, we use PathManager which provides filesystem-based autocomplete
-			t.pathSuggestions.UpdateFilter(t.inputs[i].Value())
+	for i := range t.inputs {
+		if i == cmdsField {
+			cmds = append(cmds, t.commandEditor.Update(msg))
+			if i == t.nav.FocusIndex {
+				cmds = append(cmds, t.commandSuggestions.Query(t.commandEditor.FocusedCommand()))
+			}
+			continue
 		}
 
-		if i == iconField && i == t.nav.FocusIndex {
-			t.iconSuggestions.UpdateFilter(t.inputs[i].Value())
-		}
+		var cmd tea.Cmd
+		t.inputs[i], cmd = t.inputs[i].Update(msg)
+		cmds = append(cmds, cmd)
 
-		if i == iconColorField && i == t.nav.FocusIndex {
-			t.colorSuggestions.UpdateFilter(t.inputs[i].Value())
+		// Query the suggestions of the focused field, path suggestions are listed in the background
+		if i == t.nav.FocusIndex {
+			if i == pathField {
+				cmds = append(cmds, t.pathSuggestions.Query(t.inputs[i].Value()))
+			} else if manager := t.getCurrentSuggestionManager(); manager != nil {
+				cmds = append(cmds, manager.Query(t.inputs[i].Value()))
+			}
 		}
 	}
 
+	// Validate the focused field once typing pauses
+	if t.fieldValue(focused) != before {
+		cmds = append(cmds, t.validator.Changed(focused))
+	}
+
 	return tea.Batch(cmds...)
 }
 
 // View renders the TUI form view for creating/editing tasks.
 func (t *TaskModel) View() string {
+	if t.iconPicker != nil {
+		return styles.FormContainerStyle.Render(t.iconPicker.View())
+	}
+
 	var sections []string
 	
 	title := "CREATE TASK"
@@ -171,25 +299,35 @@ func (t *TaskModel) View() string {
 	
 	labels := []string{
 		"Task Name:",
+		"Description:",
 		"Project Path:",
 		"Commands:",
+		"Tags:",
 		"Icon:",
 		"Icon Color:",
 	}
 	
 	for i := range t.inputs {
+		input := t.inputs[i].View()
+		if i == cmdsField {
+			input = t.commandEditor.View()
+		}
+
 		fieldContent := lipgloss.JoinVertical(
 			lipgloss.Left,
 			styles.FieldLabelStyle.Render(labels[i]),
-			t.inputs[i].View(),
+			input,
 		)
+
+		// Show the problem found by the last validation under the field
+		if problem := t.validator.RenderProblem(i); problem != "" {
+			fieldContent = lipgloss.JoinVertical(lipgloss.Left, fieldContent, problem)
+		}
 		
 		// Show suggestions for the current focused field
 		if t.nav.FocusIndex == i {
 			var suggestionBox string
-			if i == pathField && t.pathSuggestions.ShouldShow(t.inputs[i].Value()) {
-				suggestionBox = t.pathSuggestions.Render()
-			} else if manager := t.getCurrentSuggestionManager(); manager != nil && manager.ShouldShow(t.inputs[i].Value()) {
+			if manager := t.getCurrentSuggestionManager(); manager != nil && manager.ShouldShow(t.focusedValue()) {
 				suggestionBox = manager.Render()
 			}
 			
@@ -205,19 +343,23 @@ func (t *TaskModel) View() string {
 		sections = append(sections, styles.FieldContainerStyle.Render(fieldContent))
 	}
 	
+	sections = append(sections, t.renderPreview())
+
 	// Render messages if any exist
 	if t.messages.HasMessages() {
 		sections = append(sections, t.messages.Render())
 	}
 	
 	button := styles.RenderBlurredButton("Submit")
-	if t.nav.FocusIndex == len(t.inputs) {
+	if !t.validator.Valid() {
+		button = styles.RenderDisabledButton("Submit")
+	} else if t.nav.FocusIndex == len(t.inputs) {
 		button = styles.RenderFocusedButton("Submit")
 	}
 	
 	sections = append(sections, button)
 	
-	helpText := styles.HelpTextStyle.Render("↑/↓ navigate • ctrl+b/n suggestions • tab/enter apply • esc quit")
+	helpText := styles.HelpTextStyle.Render("↑/↓ navigate • ctrl+b/n suggestions • tab/enter apply • ctrl+o icon picker • esc quit")
 	sections = append(sections, helpText)
 	
 	return styles.FormContainerStyle.Render(
@@ -225,11 +367,39 @@ func (t *TaskModel) View() string {
 	)
 }
 
+// renderPreview renders the task name in its icon color next to the icon description,
+// approximating the terminal tab VSCode will open.
+func (t *TaskModel) renderPreview() string {
+	name := strings.TrimSpace(t.inputs[nameField].Value())
+	if name == "" {
+		name = "Task name"
+	}
+
+	nameStyle := styles.RunnerTaskNameStyle
+	if color, found := styles.FindANSIColor(t.inputs[iconColorField].Value()); found {
+		nameStyle = nameStyle.Foreground(color.Color)
+	}
+
+	iconDescription := "Unknown icon"
+	if icon, found := styles.FindIcon(t.inputs[iconField].Value()); found {
+		iconDescription = icon.Desc
+	}
+
+	return lipgloss.JoinHorizontal(lipgloss.Top,
+		styles.FieldLabelStyle.Render("Preview: "),
+		styles.RenderColorSwatch(t.inputs[iconColorField].Value())+" ",
+		nameStyle.Render(name),
+		styles.LightGrayStyle.Render("  ("+iconDescription+")"),
+	)
+}
+
 // getCurrentSuggestionManager returns the suggestion manager for the current field.
 func (t *TaskModel) getCurrentSuggestionManager() *suggestions.Manager {
 	switch t.nav.FocusIndex {
 	case pathField:
 		return t.pathSuggestions.Manager
+	case cmdsField:
+		return t.commandSuggestions
 	case iconField:
 		return t.iconSuggestions
 	case iconColorField:
@@ -239,3 +409,38 @@ func (t *TaskModel) getCurrentSuggestionManager() *suggestions.Manager {
 	}
 }
 
+
+// focusedValue returns the text of the focused field, the focused command for the commands field.
+func (t *TaskModel) focusedValue() string {
+	if t.nav.FocusIndex == cmdsField {
+		return t.commandEditor.FocusedCommand()
+	}
+	if t.nav.FocusIndex < len(t.inputs) {
+		return t.inputs[t.nav.FocusIndex].Value()
+	}
+	return ""
+}
+
+// applySuggestion copies the selected suggestion into the focused field.
+// It reports false when the field shows no suggestions.
+func (t *TaskModel) applySuggestion() (tea.Cmd, bool) {
+	manager := t.getCurrentSuggestionManager()
+	if manager == nil || !manager.ShouldShow(t.focusedValue()) {
+		return nil, false
+	}
+
+	validate := t.validator.Changed(t.nav.FocusIndex)
+	switch t.nav.FocusIndex {
+	case pathField:
+		return tea.Batch(t.pathSuggestions.ApplySelected(&t.inputs[pathField]), validate), true
+	case cmdsField:
+		if selected := manager.GetSelected(); selected != "" {
+			t.commandEditor.SetFocusedCommand(selected)
+			manager.Reset()
+		}
+		return tea.Batch(manager.Query(t.commandEditor.FocusedCommand()), validate), true
+	default:
+		manager.ApplySelected(&t.inputs[t.nav.FocusIndex])
+		return validate, true
+	}
+}
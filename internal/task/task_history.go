@@ -0,0 +1,40 @@
+package task
+
+import (
+	"time"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/repository"
+	"github.com/samber/lo"
+)
+
+// applyUsageHistory ranks the path, command, icon and color suggestions by how often and how recently
+// they were saved. An unreadable history leaves the suggestions in their default order.
+func (t *TaskModel) applyUsageHistory() {
+	history, err := repository.LoadHistory()
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	boost := func(kind repository.HistoryKind) func(string) int {
+		return func(value string) int { return history.Frecency(kind, value, now) }
+	}
+
+	t.iconSuggestions.SetBoostFunc(boost(repository.HistoryIcons))
+	t.colorSuggestions.SetBoostFunc(boost(repository.HistoryColors))
+	t.commandSuggestions.SetBoostFunc(boost(repository.HistoryCommands))
+
+	usedPaths := history.Values(repository.HistoryPaths, now)
+	t.pathSuggestions.SetFrequentPaths(lo.SliceToMap(usedPaths, func(path string) (string, int) {
+		return path, history.Frecency(repository.HistoryPaths, path, now)
+	}))
+}
+
+// recordUsage adds the values of a saved task to the usage history when it is enabled.
+// The task is already saved, so a failure to record is not reported.
+func recordUsage(enabled bool, task models.Task) {
+	if enabled {
+		_ = repository.RecordTaskUsage(task)
+	}
+}
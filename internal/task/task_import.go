@@ -0,0 +1,63 @@
+package task
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/repository"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
+	"github.com/samber/lo"
+)
+
+// importTasksFromFile saves the tasks of a JSON file and prints what happened to each one.
+func importTasksFromFile(path, onConflict string) error {
+	policy, err := repository.ParseConflictPolicy(onConflict)
+	if err != nil {
+		return err
+	}
+
+	results, err := repository.SaveFromFile(path, policy, ValidateTask)
+	if err != nil {
+		return err
+	}
+
+	printImportSummary(results)
+
+	invalid := lo.CountBy(results, func(result repository.ImportResult) bool {
+		return result.Action == repository.ImportInvalid
+	})
+	if invalid > 0 {
+		return fmt.Errorf("%d invalid task(s) were not imported", invalid)
+	}
+	return nil
+}
+
+// printImportSummary prints one line per imported task followed by the totals.
+func printImportSummary(results []repository.ImportResult) {
+	counts := make(map[repository.ImportAction]int)
+
+	for _, result := range results {
+		counts[result.Action]++
+
+		switch result.Action {
+		case repository.ImportCreated:
+			styles.PrintSuccess(fmt.Sprintf("%s: created", result.Name))
+		case repository.ImportOverwritten:
+			styles.PrintWarning(fmt.Sprintf("%s: overwritten", result.Name))
+		case repository.ImportRenamed:
+			styles.PrintInfo(fmt.Sprintf("%s: name taken, saved as '%s'", result.Name, result.RenamedTo))
+		case repository.ImportSkipped:
+			styles.PrintInfo(fmt.Sprintf("%s: name taken, skipped", result.Name))
+		case repository.ImportInvalid:
+			styles.PrintError(fmt.Sprintf("%s: invalid, %s", result.Name, strings.Join(result.Problems, "; ")))
+		}
+	}
+
+	fmt.Printf("\n%d created, %d overwritten, %d renamed, %d skipped, %d invalid\n",
+		counts[repository.ImportCreated],
+		counts[repository.ImportOverwritten],
+		counts[repository.ImportRenamed],
+		counts[repository.ImportSkipped],
+		counts[repository.ImportInvalid],
+	)
+}
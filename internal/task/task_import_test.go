@@ -0,0 +1,55 @@
+package task
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/paths"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/repository"
+	"github.com/samber/lo"
+)
+
+func TestImportValidation(t *testing.T) {
+	// Arrange
+	t.Setenv(paths.ConfigDirEnv, t.TempDir())
+	t.Setenv(paths.ProfileEnv, "")
+
+	projectDir := t.TempDir()
+	valid := models.Task{Name: "api", Path: projectDir, Cmds: []string{"go run ."}, Icon: "terminal", IconColor: "terminal.ansiGreen"}
+	blankName, noCommands, unknownIcon := valid, valid, valid
+	blankName.Name = "  "
+	noCommands.Name, noCommands.Cmds = "web", nil
+	unknownIcon.Name, unknownIcon.Icon = "worker", "rockett"
+
+	file := filepath.Join(t.TempDir(), "tasks.json")
+	content, _ := json.Marshal([]models.Task{valid, blankName, noCommands, unknownIcon})
+	if err := os.WriteFile(file, content, 0644); err != nil {
+		t.Fatalf("failed to write import file: %v", err)
+	}
+
+	// Act
+	results, err := repository.SaveFromFile(file, repository.ConflictSkip, ValidateTask)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	invalid := lo.FilterMap(results, func(result repository.ImportResult, _ int) (string, bool) {
+		return result.Name, result.Action == repository.ImportInvalid && len(result.Problems) > 0
+	})
+	if expected := []string{"task #2", "web", "worker"}; !lo.ElementsMatch(invalid, expected) {
+		t.Errorf("expected invalid tasks %v, got %v", expected, invalid)
+	}
+
+	saved, err := repository.ReadTasks()
+	if err != nil {
+		t.Fatalf("failed to read tasks: %v", err)
+	}
+	if len(saved) != 1 || saved[0].Name != "api" {
+		t.Errorf("expected only the valid task to be saved, got %+v", saved)
+	}
+}
@@ -8,17 +8,27 @@ import (
 
 	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
 	"github.com/DieGopherLT/vscode-terminal-runner/internal/repository"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/output"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
+	"github.com/samber/lo"
 )
 
-
-func listAllTasks() error {
+// listAllTasks prints the saved tasks in the format chosen with --output.
+// Tasks are limited to those with any of tags and matching the search query, when given.
+func listAllTasks(tags []string, search string) error {
 	tasks, err := repository.ReadTasks()
 	if err != nil {
 		return err
 	}
 
+	tasks = filterTasks(tasks, tags, search)
+
+	if !output.IsTable() {
+		return output.Print(normalizeTasks(tasks), repository.TaskNames(tasks))
+	}
+
 	if len(tasks) == 0 {
-		fmt.Println("No tasks found.")
+		fmt.Println(lo.Ternary(len(tags) > 0 || search != "", "No tasks match the filters.", "No tasks found."))
 		return nil
 	}
 
@@ -26,15 +36,12 @@ func listAllTasks() error {
 	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
 	defer writer.Flush()
 
-	strBuilder.WriteString("Name\tPath\tCommands\tIcon\tIcon Color\n")
+	strBuilder.WriteString("Name\tPath\tCommands\tTags\tIcon\tIcon Color\n")
 	for _, task := range tasks {
 		strBuilder.WriteString(task.Name + "\t")
-
-		if strings.HasPrefix(task.Path, os.Getenv("HOME")) {
-			task.Path = strings.Replace(task.Path, os.Getenv("HOME"), "~", 1)
-		}
-		strBuilder.WriteString(task.Path + "\t")
+		strBuilder.WriteString(shortenHome(task.Path) + "\t")
 		strBuilder.WriteString(strings.Join(task.Cmds, ", ") + "\t")
+		strBuilder.WriteString(strings.Join(task.Tags, ", ") + "\t")
 		strBuilder.WriteString(task.Icon + "\t")
 		strBuilder.WriteString(task.IconColor + "\n")
 	}
@@ -42,27 +49,60 @@ func listAllTasks() error {
 	return err
 }
 
-func listAllTaskNames() error {
-	tasks, err := repository.ReadTasks()
+// showTask prints a single task in the format chosen with --output.
+func showTask(name string) error {
+	task, err := repository.FindTaskByName(name)
 	if err != nil {
 		return err
 	}
 
-	if len(tasks) == 0 {
-		fmt.Println("No tasks found.")
-		return nil
+	if !output.IsTable() {
+		return output.Print(normalizeTasks([]models.Task{*task})[0], []string{task.Name})
 	}
 
-	var strBuilder strings.Builder
-	strBuilder.WriteString("Task Names:\n")
-	for _, task := range tasks {
-		strBuilder.WriteString("- " + task.Name + "\n")
+	fmt.Println(styles.RunnerHeaderStyle.Render("TASK: " + task.Name))
+	if task.Description != "" {
+		fmt.Println(styles.RunnerInfoStyle.Render(task.Description))
+	}
+	fmt.Println(styles.RunnerInfoStyle.Render(fmt.Sprintf("Icon: %s (%s)", task.Icon, task.IconColor)))
+	fmt.Println(styles.RunnerInfoStyle.Render(fmt.Sprintf("Path: %s", shortenHome(task.Path))))
+	if len(task.Tags) > 0 {
+		fmt.Println(styles.RunnerInfoStyle.Render(fmt.Sprintf("Tags: %s", strings.Join(task.Tags, ", "))))
+	}
+	fmt.Println()
+
+	for _, cmd := range task.Cmds {
+		fmt.Println(styles.RunnerInfoStyle.Render(fmt.Sprintf("  $ %s", cmd)))
 	}
-	fmt.Println(strBuilder.String())
 	return nil
 }
 
+// filterTasks keeps the tasks carrying any of tags (when given) that match the search query.
+func filterTasks(tasks []models.Task, tags []string, search string) []models.Task {
+	return lo.Filter(tasks, func(task models.Task, _ int) bool {
+		hasTag := len(tags) == 0 || lo.SomeBy(tags, func(tag string) bool { return repository.HasTag(task, tag) })
+		return hasTag && repository.TaskMatchesQuery(task, search)
+	})
+}
+
+// normalizeTasks replaces nil slices with empty ones so JSON output never contains null lists.
+func normalizeTasks(tasks []models.Task) []models.Task {
+	return lo.Map(tasks, func(task models.Task, _ int) models.Task {
+		task.Cmds = lo.Ternary(task.Cmds == nil, []string{}, task.Cmds)
+		return task
+	})
+}
+
+// shortenHome replaces the home directory prefix with ~ for display.
+func shortenHome(path string) string {
+	home := os.Getenv("HOME")
+	if home != "" && strings.HasPrefix(path, home) {
+		return strings.Replace(path, home, "~", 1)
+	}
+	return path
+}
+
 // FindByName retrieves a task by its name from the saved tasks
 func FindByName(name string) (*models.Task, error) {
 	return repository.FindTaskByName(name)
-}
\ No newline at end of file
+}
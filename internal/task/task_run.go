@@ -0,0 +1,61 @@
+package task
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/repository"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/vscode"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
+	"github.com/samber/lo"
+)
+
+// runTasks resolves names, globs and tags to a task set and launches it over a single bridge connection.
+func runTasks(patterns, tags []string) error {
+	tasks, err := resolveRunTasks(patterns, tags)
+	if err != nil {
+		return err
+	}
+
+	runner, err := vscode.NewSecureRunner()
+	if err != nil {
+		return fmt.Errorf("failed to create secure runner: %w", err)
+	}
+
+	names := lo.Map(tasks, func(task models.Task, _ int) string { return task.Name })
+	styles.PrintProgress(fmt.Sprintf("Detected secure VSCode instance, proceeding to run %s...", strings.Join(names, ", ")))
+
+	return runner.RunTasks(tasks)
+}
+
+// resolveRunTasks returns the tasks matching the patterns followed by the tasks carrying any of the tags,
+// without duplicates. Patterns keep the order they were given in.
+func resolveRunTasks(patterns, tags []string) ([]models.Task, error) {
+	if len(patterns) == 0 && len(tags) == 0 {
+		return nil, errors.New("pass at least one task name, glob pattern or --tag")
+	}
+
+	var tasks []models.Task
+
+	if len(patterns) > 0 {
+		matched, err := repository.MatchTasks(patterns)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, matched...)
+	}
+
+	if len(tags) > 0 {
+		tagged, err := repository.MatchTasksByTags(tags)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, tagged...)
+	}
+
+	return lo.UniqBy(tasks, func(task models.Task) string {
+		return strings.ToLower(task.Name)
+	}), nil
+}
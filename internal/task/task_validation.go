@@ -0,0 +1,157 @@
+package task
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/tui"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/tui/suggestions"
+	"github.com/samber/lo"
+)
+
+// ValidateTask checks a task before it is saved and returns a message for every problem found.
+// It is shared by the TUI form and the non-interactive commands.
+func ValidateTask(task models.Task) []string {
+	problems := []string{
+		validateName(task.Name),
+		validatePath(task.Path),
+		validateCommands(task.Cmds),
+		validateIcon(task.Icon),
+		validateIconColor(task.IconColor),
+		validateTags(task.Tags),
+	}
+	return lo.Compact(problems)
+}
+
+// validateName requires a name that is not only whitespace.
+func validateName(name string) string {
+	if strings.TrimSpace(name) == "" {
+		return "Name is required"
+	}
+	return ""
+}
+
+// validatePath checks that the path exists when one is given.
+func validatePath(p string) string {
+	p = strings.TrimSpace(p)
+	if p == "" {
+		return ""
+	}
+
+	// Expand ~ to home directory for validation
+	expandedPath := expandPathForValidation(p)
+
+	if strings.HasSuffix(p, ".") {
+		expandedPath = path.Join(os.Getenv("PWD"), expandedPath)
+	}
+	if _, err := os.Stat(expandedPath); os.IsNotExist(err) {
+		return "Path does not exist"
+	}
+	return ""
+}
+
+// validateCommands requires at least one command that is not blank.
+func validateCommands(cmds []string) string {
+	if !lo.SomeBy(cmds, func(cmd string) bool { return strings.TrimSpace(cmd) != "" }) {
+		return "At least one command is required"
+	}
+	return ""
+}
+
+// validateIcon requires a known VSCode icon, suggesting the closest name otherwise.
+func validateIcon(icon string) string {
+	if _, found := styles.FindIcon(icon); found {
+		return ""
+	}
+
+	iconNames := lo.Map(styles.VSCodeIcons, func(i styles.VSCodeIcon, _ int) string { return i.Name })
+	return unknownValueProblem("icon", icon, iconNames)
+}
+
+// validateIconColor requires a known terminal color, suggesting the closest name otherwise.
+func validateIconColor(color string) string {
+	if _, found := styles.FindANSIColor(color); found {
+		return ""
+	}
+
+	colorNames := lo.Map(styles.VSCodeANSIColors, func(c styles.VSCodeANSIColor, _ int) string { return c.Name })
+	return unknownValueProblem("icon color", color, colorNames)
+}
+
+// validateTags rejects tags with characters that break the tag filters.
+func validateTags(tags []string) string {
+	if lo.SomeBy(tags, func(tag string) bool { return strings.ContainsAny(tag, " \t,#") }) {
+		return "Tags cannot contain spaces, commas or #"
+	}
+	return ""
+}
+
+// unknownValueProblem describes a value missing from known, with a "did you mean" hint when one is close.
+func unknownValueProblem(kind, value string, known []string) string {
+	if strings.TrimSpace(value) == "" {
+		return fmt.Sprintf("An %s is required", kind)
+	}
+	if closest, found := suggestions.Closest(known, value); found {
+		return fmt.Sprintf("Unknown %s '%s', did you mean '%s'?", kind, value, closest)
+	}
+	return fmt.Sprintf("Unknown %s '%s'", kind, value)
+}
+
+// registerValidators validates the form fields as the user types. Names are unique among
+// otherTasks, compared case-insensitively like the repository does.
+func (t *TaskModel) registerValidators(otherTasks []models.Task) {
+	t.validator = tui.NewFormValidator()
+
+	t.validator.Register(nameField, func(name string) string {
+		name = strings.TrimSpace(name)
+		if problem := validateName(name); problem != "" {
+			return problem
+		}
+		if lo.SomeBy(otherTasks, func(task models.Task) bool { return strings.EqualFold(task.Name, name) }) {
+			return fmt.Sprintf("A task named '%s' already exists", name)
+		}
+		return ""
+	}, t.fieldValue(nameField))
+
+	t.validator.Register(pathField, validatePath, t.fieldValue(pathField))
+	t.validator.Register(cmdsField, func(cmds string) string {
+		return validateCommands(strings.Split(cmds, "\n"))
+	}, t.fieldValue(cmdsField))
+	t.validator.Register(tagsField, func(tags string) string {
+		return validateTags(cleanTags(strings.Split(tags, ",")))
+	}, t.fieldValue(tagsField))
+	t.validator.Register(iconField, validateIcon, t.fieldValue(iconField))
+	t.validator.Register(iconColorField, validateIconColor, t.fieldValue(iconColorField))
+}
+
+// fieldValue returns the value of a form field, the commands one per line for the commands field.
+// The submit button has no value.
+func (t *TaskModel) fieldValue(field int) string {
+	if field >= len(t.inputs) {
+		return ""
+	}
+	if field == cmdsField {
+		return strings.Join(t.commandEditor.Commands(), "\n")
+	}
+	return t.inputs[field].Value()
+}
+
+// expandPathForValidation expands ~ to home directory for path validation
+func expandPathForValidation(path string) string {
+	if strings.HasPrefix(path, "~/") || path == "~" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return path // Return original if can't get home
+		}
+		if path == "~" {
+			return home
+		}
+		return filepath.Join(home, path[2:])
+	}
+	return path
+}
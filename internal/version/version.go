@@ -0,0 +1,83 @@
+// Package version compares the dotted version strings reported by the VSTR-Bridge extension.
+package version
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// MinExtensionVersion is the oldest VSTR-Bridge extension release supported by this CLI.
+	MinExtensionVersion = "0.2.0"
+
+	// ProtocolVersion is the bridge protocol version spoken by this CLI.
+	ProtocolVersion = "1.0"
+)
+
+// Parse splits a dotted version such as "v1.2.3-beta" into its numeric components.
+// Pre-release and build metadata suffixes are ignored.
+func Parse(v string) ([]int, error) {
+	trimmed := strings.TrimPrefix(strings.TrimSpace(v), "v")
+	if idx := strings.IndexAny(trimmed, "-+"); idx >= 0 {
+		trimmed = trimmed[:idx]
+	}
+
+	if trimmed == "" {
+		return nil, fmt.Errorf("invalid version %q", v)
+	}
+
+	parts := strings.Split(trimmed, ".")
+	numbers := make([]int, len(parts))
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid version %q", v)
+		}
+		numbers[i] = n
+	}
+
+	return numbers, nil
+}
+
+// Compare returns -1, 0 or 1 depending on whether a is older, equal or newer than b.
+// Missing components are treated as zero, so "1.2" equals "1.2.0".
+func Compare(a, b string) (int, error) {
+	left, err := Parse(a)
+	if err != nil {
+		return 0, err
+	}
+	right, err := Parse(b)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := 0; i < max(len(left), len(right)); i++ {
+		l, r := component(left, i), component(right, i)
+		switch {
+		case l < r:
+			return -1, nil
+		case l > r:
+			return 1, nil
+		}
+	}
+
+	return 0, nil
+}
+
+// AtLeast reports whether v is equal to or newer than minimum.
+func AtLeast(v, minimum string) (bool, error) {
+	cmp, err := Compare(v, minimum)
+	if err != nil {
+		return false, err
+	}
+	return cmp >= 0, nil
+}
+
+// component returns the i-th version component or zero when it does not exist.
+func component(numbers []int, i int) int {
+	if i >= len(numbers) {
+		return 0
+	}
+	return numbers[i]
+}
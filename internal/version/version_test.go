@@ -0,0 +1,72 @@
+package version
+
+import "testing"
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected int
+		wantErr  bool
+	}{
+		{name: "equal versions", a: "1.2.3", b: "1.2.3", expected: 0},
+		{name: "older patch", a: "1.2.3", b: "1.2.4", expected: -1},
+		{name: "newer minor", a: "1.3.0", b: "1.2.9", expected: 1},
+		{name: "numeric not lexical", a: "0.10.0", b: "0.9.0", expected: 1},
+		{name: "missing components are zero", a: "1.2", b: "1.2.0", expected: 0},
+		{name: "leading v is ignored", a: "v2.0.0", b: "2.0.0", expected: 0},
+		{name: "pre-release suffix is ignored", a: "1.0.0-beta", b: "1.0.0", expected: 0},
+		{name: "invalid version", a: "latest", b: "1.0.0", wantErr: true},
+		{name: "empty version", a: "", b: "1.0.0", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			result, err := Compare(tt.a, tt.b)
+
+			// Assert
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error comparing %q and %q", tt.a, tt.b)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if result != tt.expected {
+				t.Errorf("Compare(%q, %q) = %d, expected %d", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAtLeast(t *testing.T) {
+	tests := []struct {
+		name     string
+		v        string
+		minimum  string
+		expected bool
+	}{
+		{name: "same version", v: MinExtensionVersion, minimum: MinExtensionVersion, expected: true},
+		{name: "newer version", v: "9.0.0", minimum: MinExtensionVersion, expected: true},
+		{name: "older version", v: "0.0.1", minimum: MinExtensionVersion, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := AtLeast(tt.v, tt.minimum)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if result != tt.expected {
+				t.Errorf("AtLeast(%q, %q) = %v, expected %v", tt.v, tt.minimum, result, tt.expected)
+			}
+		})
+	}
+}
@@ -4,22 +4,30 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
-	"time"
 
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/cfg"
 	"github.com/DieGopherLT/vscode-terminal-runner/internal/client"
 	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/paths"
 	"github.com/DieGopherLT/vscode-terminal-runner/internal/repository"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/version"
 	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
 )
 
 // SecureRunner orchestrates secure execution of tasks in VSCode terminals via authenticated bridge
 type SecureRunner struct {
 	client *client.SecureClient
+	config models.Config
 }
 
 // NewSecureRunner creates a new secure runner instance connected to VSCode bridge
 func NewSecureRunner() (*SecureRunner, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	config, err := cfg.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Seconds(config.ConnectTimeout))
 	defer cancel()
 	
 	// 1. Discover secure bridge
@@ -32,7 +40,7 @@ func NewSecureRunner() (*SecureRunner, error) {
 	styles.PrintInfo(fmt.Sprintf("Workspace: %s", bridgeInfo.WorkspaceName))
 	
 	// 2. Create secure client
-	secureClient := client.NewSecureClient(bridgeInfo.Port)
+	secureClient := client.NewSecureClient(bridgeInfo.Port, cfg.Seconds(max(config.TaskTimeout, config.WorkspaceTimeout)))
 	
 	// 3. Load authentication
 	bridgeFilePath := filepath.Join(getBridgeDirectory(), fmt.Sprintf("bridge-%d.json", bridgeInfo.Port))
@@ -41,18 +49,20 @@ func NewSecureRunner() (*SecureRunner, error) {
 	}
 	
 	// 4. Test connection and authentication
-	if err := secureClient.TestConnection(ctx); err != nil {
+	pingResp, err := secureClient.TestConnection(ctx)
+	if err != nil {
 		return nil, fmt.Errorf("secure connection test failed: %w", err)
 	}
 	
 	styles.PrintSuccess("✓ Successfully connected to secure bridge")
+	warnOutdatedBridge(pingResp)
 	
-	return &SecureRunner{client: secureClient}, nil
+	return &SecureRunner{client: secureClient, config: config}, nil
 }
 
 // RunTask executes a single task in a new VSCode terminal securely
 func (sr *SecureRunner) RunTask(taskName string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Seconds(sr.config.TaskTimeout))
 	defer cancel()
 	
 	// Find the task
@@ -77,17 +87,38 @@ func (sr *SecureRunner) RunTask(taskName string) error {
 
 // RunWorkspace executes all tasks in a workspace securely
 func (sr *SecureRunner) RunWorkspace(workspaceName string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
-	defer cancel()
-	
 	// Load workspace from repository
 	workspace, err := repository.FindWorkspaceByName(workspaceName)
 	if err != nil {
 		return fmt.Errorf("workspace not found: %w", err)
 	}
+
+	return sr.LaunchWorkspace(*workspace)
+}
+
+// RunTasks launches several tasks as an ad-hoc workspace over the existing connection
+func (sr *SecureRunner) RunTasks(tasks []models.Task) error {
+	if len(tasks) == 0 {
+		return fmt.Errorf("no tasks to run")
+	}
+
+	if len(tasks) == 1 {
+		return sr.RunTask(tasks[0].Name)
+	}
+
+	return sr.LaunchWorkspace(models.Workspace{
+		Name:  fmt.Sprintf("ad-hoc (%d tasks)", len(tasks)),
+		Tasks: tasks,
+	})
+}
+
+// LaunchWorkspace sends a workspace, saved or built on the fly, to the bridge in a single request
+func (sr *SecureRunner) LaunchWorkspace(workspace models.Workspace) error {
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Seconds(sr.config.WorkspaceTimeout))
+	defer cancel()
 	
 	if len(workspace.Tasks) == 0 {
-		return fmt.Errorf("no tasks found in workspace '%s'", workspaceName)
+		return fmt.Errorf("no tasks found in workspace '%s'", workspace.Name)
 	}
 	
 	// Display workspace info
@@ -96,7 +127,7 @@ func (sr *SecureRunner) RunWorkspace(workspaceName string) error {
 	styles.PrintProgress(fmt.Sprintf("Launching %d secure terminals...", len(workspace.Tasks)))
 	
 	// Send to secure bridge
-	if err := sr.client.ExecuteWorkspace(ctx, *workspace); err != nil {
+	if err := sr.client.ExecuteWorkspace(ctx, workspace); err != nil {
 		return handleSecureError(err)
 	}
 	
@@ -122,6 +153,9 @@ func (sr *SecureRunner) displayTaskInfo(task *models.Task) {
 // displayWorkspaceInfo shows workspace details before launching
 func (sr *SecureRunner) displayWorkspaceInfo(name string, tasks []models.Task) {
 	fmt.Println(styles.RunnerHeaderStyle.Render("SECURE WORKSPACE: " + name))
+	if activeProfile, err := paths.ActiveProfile(); err == nil {
+		fmt.Println(styles.RunnerInfoStyle.Render(fmt.Sprintf("Profile: %s", activeProfile)))
+	}
 	fmt.Println(styles.RunnerInfoStyle.Render(fmt.Sprintf("Tasks to launch: %d", len(tasks))))
 	fmt.Println()
 	
@@ -131,3 +165,17 @@ func (sr *SecureRunner) displayWorkspaceInfo(name string, tasks []models.Task) {
 	fmt.Println()
 }
 
+// warnOutdatedBridge warns when the bridge reports an older protocol than the one spoken by this CLI
+func warnOutdatedBridge(pingResp *client.PingResponse) {
+	if pingResp.ProtocolVersion == "" {
+		return
+	}
+
+	upToDate, err := version.AtLeast(pingResp.ProtocolVersion, version.ProtocolVersion)
+	if err == nil && upToDate {
+		return
+	}
+
+	styles.PrintWarning(fmt.Sprintf("Bridge speaks protocol %s but this CLI expects %s.", pingResp.ProtocolVersion, version.ProtocolVersion))
+	styles.PrintInfo("Update the VSTR-Bridge extension with 'vstr doctor' if you run into unexpected errors.")
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/cfg"
 	"github.com/DieGopherLT/vscode-terminal-runner/internal/security"
 	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
 	"github.com/samber/lo"
@@ -121,7 +122,7 @@ func DiscoverSecureBridge() (*BridgeInfo, error) {
 
 // ListAvailableBridges scans for active bridge instances
 func ListAvailableBridges() ([]BridgeInfo, error) {
-	tmpDir := filepath.Join(os.TempDir(), "vstr-bridge")
+	tmpDir := getBridgeDirectory()
 
 	files, err := os.ReadDir(tmpDir)
 	if err != nil {
@@ -296,12 +297,20 @@ func detectParentVSCode() (*VSCodeInstance, error) {
 	return nil, fmt.Errorf("VSCode parent process not found")
 }
 
-// isVSCodeProcess checks if a process name matches VSCode
+// vscodeProcessNames lists process name fragments of VSCode and its forks
+var vscodeProcessNames = []string{
+	"code",     // VSCode and VSCode Insiders
+	"codium",   // VSCodium
+	"cursor",   // Cursor
+	"electron", // VSCode uses Electron
+}
+
+// isVSCodeProcess checks if a process name matches VSCode or one of its forks
 func isVSCodeProcess(name string) bool {
 	lowerName := strings.ToLower(name)
-	return strings.Contains(lowerName, "code") || 
-	       strings.Contains(lowerName, "code-insiders") ||
-	       strings.Contains(lowerName, "electron") // VSCode uses Electron
+	return lo.SomeBy(vscodeProcessNames, func(fragment string) bool {
+		return strings.Contains(lowerName, fragment)
+	})
 }
 
 // extractWorkspacePath tries to extract workspace path from command line
@@ -325,8 +334,12 @@ func extractWorkspacePath(cmdline string) string {
 	return ""
 }
 
-// getBridgeDirectory returns the platform-specific bridge directory
+// getBridgeDirectory returns the configured bridge directory or the platform-specific default
 func getBridgeDirectory() string {
+	if config, err := cfg.Load(); err == nil && config.BridgeDir != "" {
+		return config.BridgeDir
+	}
+
 	var tmpDir string
 	
 	switch runtime.GOOS {
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/repository"
 	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
@@ -24,7 +25,7 @@ const (
 // TaskSelector provides multi-select functionality for tasks with search capabilities.
 type TaskSelector struct {
 	availableTasks    []models.Task
-	selectedTasks     map[string]bool
+	selectedOrder     []string // Names of the selected tasks, in launch order
 	filteredTasks     []models.Task
 	focusedIndex      int
 	searchInput       textinput.Model
@@ -35,12 +36,12 @@ type TaskSelector struct {
 // NewTaskSelector creates a new task selector with the given available tasks.
 func NewTaskSelector(availableTasks []models.Task) *TaskSelector {
 	searchInput := textinput.New()
-	searchInput.Placeholder = "Search tasks..."
+	searchInput.Placeholder = "Search tasks or #tag..."
 	searchInput.CharLimit = maxSearchInputLength
 
 	return &TaskSelector{
 		availableTasks: availableTasks,
-		selectedTasks:  make(map[string]bool),
+		selectedOrder:  []string{},
 		filteredTasks:  availableTasks,
 		focusedIndex:   0,
 		searchInput:    searchInput,
@@ -49,26 +50,33 @@ func NewTaskSelector(availableTasks []models.Task) *TaskSelector {
 	}
 }
 
-// GetSelectedTasks returns a slice of currently selected tasks.
+// GetSelectedTasks returns the currently selected tasks in launch order.
 func (ts *TaskSelector) GetSelectedTasks() []models.Task {
-	return lo.Filter(ts.availableTasks, func(task models.Task, _ int) bool {
-		return ts.selectedTasks[task.Name]
+	return lo.FilterMap(ts.selectedOrder, func(name string, _ int) (models.Task, bool) {
+		return lo.Find(ts.availableTasks, func(task models.Task) bool {
+			return task.Name == name
+		})
 	})
 }
 
-// SetSelectedTasks sets the initially selected tasks.
+// SetSelectedTasks sets the initially selected tasks, keeping their order as the launch order.
 func (ts *TaskSelector) SetSelectedTasks(tasks []models.Task) {
-	ts.selectedTasks = make(map[string]bool)
-	for _, task := range tasks {
-		ts.selectedTasks[task.Name] = true
-	}
+	ts.selectedOrder = lo.Map(tasks, func(task models.Task, _ int) string { return task.Name })
 }
 
-// GetSelectedCount returns the number of currently selected tasks using functional approach.
+// GetSelectedCount returns the number of currently selected tasks.
 func (ts *TaskSelector) GetSelectedCount() int {
-	return lo.CountBy(lo.Values(ts.selectedTasks), func(isSelected bool) bool {
-		return isSelected
-	})
+	return len(ts.selectedOrder)
+}
+
+// launchPosition returns the 1-based launch position of a selected task, or 0 when it is not selected.
+func (ts *TaskSelector) launchPosition(name string) int {
+	return lo.IndexOf(ts.selectedOrder, name) + 1
+}
+
+// isSelected reports whether the named task is selected.
+func (ts *TaskSelector) isSelected(name string) bool {
+	return ts.launchPosition(name) > 0
 }
 
 // ToggleSearch toggles the search input visibility and focus.
@@ -92,13 +100,15 @@ func (ts *TaskSelector) IsInSearchMode() bool {
 // SelectAll selects all currently visible (filtered) tasks.
 func (ts *TaskSelector) SelectAll() {
 	for _, task := range ts.filteredTasks {
-		ts.selectedTasks[task.Name] = true
+		if !ts.isSelected(task.Name) {
+			ts.selectedOrder = append(ts.selectedOrder, task.Name)
+		}
 	}
 }
 
 // DeselectAll deselects all currently selected tasks.
 func (ts *TaskSelector) DeselectAll() {
-	ts.selectedTasks = make(map[string]bool)
+	ts.selectedOrder = []string{}
 }
 
 // ToggleSelected toggles the selection state of the currently focused task.
@@ -109,8 +119,28 @@ func (ts *TaskSelector) ToggleSelected() {
 	
 	if ts.focusedIndex >= 0 && ts.focusedIndex < len(ts.filteredTasks) {
 		task := ts.filteredTasks[ts.focusedIndex]
-		ts.selectedTasks[task.Name] = !ts.selectedTasks[task.Name]
+		if ts.isSelected(task.Name) {
+			ts.selectedOrder = lo.Without(ts.selectedOrder, task.Name)
+		} else {
+			ts.selectedOrder = append(ts.selectedOrder, task.Name)
+		}
+	}
+}
+
+// MoveSelected moves the focused task earlier (-1) or later (1) in the launch order.
+// Nothing happens when the focused task is not selected or is already at that end.
+func (ts *TaskSelector) MoveSelected(direction int) {
+	if ts.focusedIndex < 0 || ts.focusedIndex >= len(ts.filteredTasks) {
+		return
+	}
+
+	position := lo.IndexOf(ts.selectedOrder, ts.filteredTasks[ts.focusedIndex].Name)
+	target := position + direction
+	if position == -1 || target < 0 || target >= len(ts.selectedOrder) {
+		return
 	}
+
+	ts.selectedOrder[position], ts.selectedOrder[target] = ts.selectedOrder[target], ts.selectedOrder[position]
 }
 
 // MoveFocus moves the focus up or down within the visible task list.
@@ -129,6 +159,7 @@ func (ts *TaskSelector) MoveFocus(direction int) {
 }
 
 // UpdateFilter updates the task filter based on search input.
+// Words starting with # filter by tag (e.g. "#frontend"), other words match name, description or path.
 func (ts *TaskSelector) UpdateFilter() {
 	query := strings.ToLower(strings.TrimSpace(ts.searchInput.Value()))
 	
@@ -136,9 +167,7 @@ func (ts *TaskSelector) UpdateFilter() {
 		ts.filteredTasks = ts.availableTasks
 	} else {
 		ts.filteredTasks = lo.Filter(ts.availableTasks, func(task models.Task, _ int) bool {
-			nameMatch := strings.Contains(strings.ToLower(task.Name), query)
-			pathMatch := strings.Contains(strings.ToLower(task.Path), query)
-			return nameMatch || pathMatch
+			return repository.TaskMatchesQuery(task, query)
 		})
 	}
 	
@@ -181,6 +210,12 @@ func (ts *TaskSelector) View() string {
 	taskList := ts.renderTaskList()
 	sections = append(sections, taskList)
 	
+	// Launch order summary, also covering selected tasks hidden by the search
+	if selected := ts.GetSelectedTasks(); len(selected) > 0 {
+		names := lo.Map(selected, func(task models.Task, _ int) string { return task.Name })
+		sections = append(sections, "", styles.LightGrayStyle.Render("Launch order: "+strings.Join(names, " → ")))
+	}
+	
 	// Add spacing before help text
 	sections = append(sections, "")
 	
@@ -233,8 +268,10 @@ func (ts *TaskSelector) renderTaskList() string {
 func (ts *TaskSelector) renderTaskItem(task models.Task, index int) string {
 	// Checkbox state
 	checkbox := "☐"
-	if ts.selectedTasks[task.Name] {
+	launchOrder := "   "
+	if position := ts.launchPosition(task.Name); position > 0 {
 		checkbox = "☑"
+		launchOrder = fmt.Sprintf("%2d.", position)
 	}
 	
 	// Focus indicator and styling
@@ -244,7 +281,7 @@ func (ts *TaskSelector) renderTaskItem(task models.Task, index int) string {
 	if index == ts.focusedIndex {
 		focusPrefix = "▶ "
 		itemStyle = styles.FocusedTaskStyle
-	} else if ts.selectedTasks[task.Name] {
+	} else if ts.isSelected(task.Name) {
 		itemStyle = styles.SelectedTaskStyle
 	}
 	
@@ -252,10 +289,13 @@ func (ts *TaskSelector) renderTaskItem(task models.Task, index int) string {
 	displayPath := truncatePath(task.Path, maxPathDisplayLength)
 	
 	// Format item text with fixed-width columns (grid-like alignment)
-	// Focus(2) + Checkbox(2) + TaskName(25) + Icon(4) + Path(remaining)
+	// Focus(2) + Checkbox(2) + LaunchOrder(4) + TaskName(25) + Icon(4) + Path(remaining)
 	paddedTaskName := padRight(task.Name, taskNameColumnWidth)
-	itemText := fmt.Sprintf("%s%s %s 📁 %s", 
-		focusPrefix, checkbox, paddedTaskName, displayPath)
+	itemText := fmt.Sprintf("%s%s %s %s 📁 %s", 
+		focusPrefix, checkbox, launchOrder, paddedTaskName, displayPath)
+	if len(task.Tags) > 0 {
+		itemText += "  #" + strings.Join(task.Tags, " #")
+	}
 		
 	return itemStyle.Render(itemText)
 }
@@ -271,7 +311,7 @@ func (ts *TaskSelector) renderHelpText() string {
 	if ts.showSearch {
 		return styles.LightGrayStyle.Render("esc exit search • enter confirm")
 	}
-	return styles.LightGrayStyle.Render("↑/↓ navigate • space toggle • /search • ctrl+a select all • tab/shift+tab navigate")
+	return styles.LightGrayStyle.Render("↑/↓ navigate • space toggle • K/J reorder • /search • ctrl+a select all • tab/shift+tab navigate")
 }
 
 // truncatePath truncates a path to fit within the specified width, adding ellipsis if needed.
@@ -0,0 +1,90 @@
+package components
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/samber/lo"
+)
+
+func selectedNames(ts *TaskSelector) []string {
+	return lo.Map(ts.GetSelectedTasks(), func(task models.Task, _ int) string { return task.Name })
+}
+
+func TestTaskSelectorLaunchOrder(t *testing.T) {
+	available := []models.Task{{Name: "db"}, {Name: "api"}, {Name: "web"}}
+
+	tests := []struct {
+		name     string
+		actions  func(ts *TaskSelector)
+		expected []string
+	}{
+		{
+			name: "selection order is launch order",
+			actions: func(ts *TaskSelector) {
+				ts.MoveFocus(2)
+				ts.ToggleSelected() // web
+				ts.MoveFocus(-2)
+				ts.ToggleSelected() // db
+			},
+			expected: []string{"web", "db"},
+		},
+		{
+			name: "existing workspace order is kept",
+			actions: func(ts *TaskSelector) {
+				ts.SetSelectedTasks([]models.Task{{Name: "web"}, {Name: "api"}})
+			},
+			expected: []string{"web", "api"},
+		},
+		{
+			name: "move focused task earlier",
+			actions: func(ts *TaskSelector) {
+				ts.SetSelectedTasks([]models.Task{{Name: "db"}, {Name: "api"}, {Name: "web"}})
+				ts.MoveFocus(2) // web
+				ts.MoveSelected(-1)
+			},
+			expected: []string{"db", "web", "api"},
+		},
+		{
+			name: "moving past the end does nothing",
+			actions: func(ts *TaskSelector) {
+				ts.SetSelectedTasks([]models.Task{{Name: "db"}, {Name: "api"}})
+				ts.MoveSelected(-1) // db is already first
+			},
+			expected: []string{"db", "api"},
+		},
+		{
+			name: "unselecting removes the task from the order",
+			actions: func(ts *TaskSelector) {
+				ts.SetSelectedTasks([]models.Task{{Name: "db"}, {Name: "api"}, {Name: "web"}})
+				ts.MoveFocus(1) // api
+				ts.ToggleSelected()
+			},
+			expected: []string{"db", "web"},
+		},
+		{
+			name: "select all appends unselected tasks",
+			actions: func(ts *TaskSelector) {
+				ts.SetSelectedTasks([]models.Task{{Name: "web"}})
+				ts.SelectAll()
+			},
+			expected: []string{"web", "db", "api"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ts := NewTaskSelector(available)
+
+			// Act
+			tt.actions(ts)
+
+			// Assert
+			if result := selectedNames(ts); !slices.Equal(result, tt.expected) {
+				t.Errorf("expected launch order %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
@@ -2,53 +2,231 @@ package workspace
 
 import (
 	"fmt"
+	"os"
 
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/completion"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/repository"
 	"github.com/DieGopherLT/vscode-terminal-runner/internal/vscode"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/output"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/prompt"
 	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
 	"github.com/spf13/cobra"
 )
 
-// RunCmd runs a workspace by name
+// RunCmd runs a workspace by name, or a workspace built from a tag query
 var RunCmd = &cobra.Command{
-	Use:   "run <name>",
+	Use:   "run [name]",
 	Short: "Run a workspace",
-	Long:  `Execute all tasks defined in a workspace`,
-	Args:  cobra.ExactArgs(1),
+	Long: `Execute all tasks defined in a workspace.
+With --tag, only the workspace tasks carrying any of the tags are launched. Without a name,
+--tag builds a workspace from every saved task carrying any of the tags.`,
+	Example: `  vstr workspace run fullstack
+  vstr workspace run fullstack --tag backend
+  vstr workspace run --tag backend --tag db`,
+	Args: cobra.MaximumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		workspaceName := args[0]
+		tags, _ := cmd.Flags().GetStringArray("tag")
+
+		workspace, err := resolveRunWorkspace(args, tags)
+		if err != nil {
+			styles.PrintError(fmt.Sprintf("Error running workspace: %v", err))
+			os.Exit(1)
+		}
 
 		runner, err := vscode.NewSecureRunner()
 		if err != nil {
 			styles.PrintError(fmt.Sprintf("Failed to connect to secure VSCode: %v", err))
-			return
+			os.Exit(1)
 		}
 
-		if err := runner.RunWorkspace(workspaceName); err != nil {
+		if err := runner.LaunchWorkspace(*workspace); err != nil {
 			styles.PrintError(fmt.Sprintf("Error running workspace: %v", err))
-			return
+			os.Exit(1)
 		}
 	},
 }
 
-// listWorkspacesCmd lists all saved workspaces
+// ListCmd lists all saved workspaces
 var ListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all workspaces",
-	Long:  `Display a list of all configured workspaces`,
+	Long: `Display a list of all configured workspaces.
+Use --output json|yaml|names for scriptable output (see docs/OUTPUT.md).`,
 	Run: func(cmd *cobra.Command, args []string) {
-		// TODO: Implement workspace listing
-		fmt.Println("Workspace listing not yet implemented")
+		if onlyNames, _ := cmd.Flags().GetBool("only-names"); onlyNames {
+			output.SetFormat(output.Names)
+		}
+
+		if err := listAllWorkspaces(); err != nil {
+			styles.PrintError(fmt.Sprintf("Error listing workspaces: %v", err))
+			os.Exit(1)
+		}
+	},
+}
+
+// ShowCmd prints the details of a workspace
+var ShowCmd = &cobra.Command{
+	Use:   "show <name>",
+	Short: "Show a workspace",
+	Long:  `Display a workspace with the path and commands of every task it launches`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := showWorkspace(args[0]); err != nil {
+			styles.PrintError(fmt.Sprintf("Failed to show workspace: %v", err))
+			os.Exit(1)
+		}
+	},
+}
+
+// EditCmd starts the TUI form to edit an existing workspace
+var EditCmd = &cobra.Command{
+	Use:   "edit <name>",
+	Short: "Edit an existing workspace",
+	Long:  `Edit the name and tasks of an existing workspace`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := EditWorkspaceCommand(args[0]); err != nil {
+			styles.PrintError(fmt.Sprintf("Failed to edit workspace: %v", err))
+			os.Exit(1)
+		}
+	},
+}
+
+// RenameCmd renames a workspace
+var RenameCmd = &cobra.Command{
+	Use:   "rename <old> <new>",
+	Short: "Rename a workspace",
+	Long:  `Rename a workspace. Names are unique regardless of case.`,
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := repository.RenameWorkspace(args[0], args[1]); err != nil {
+			styles.PrintError(fmt.Sprintf("Failed to rename workspace: %v", err))
+			os.Exit(1)
+		}
+		styles.PrintSuccess(fmt.Sprintf("Workspace '%s' renamed to '%s'", args[0], args[1]))
+	},
+}
+
+// DeleteCmd deletes a workspace after confirmation
+var DeleteCmd = &cobra.Command{
+	Use:   "delete <name>",
+	Short: "Delete a workspace",
+	Long:  `Delete a workspace with the specified name. The tasks it contains are kept.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		skipConfirmation, _ := cmd.Flags().GetBool("yes")
+
+		workspace, err := repository.FindWorkspaceByName(args[0])
+		if err != nil {
+			styles.PrintError(err.Error())
+			os.Exit(1)
+		}
+
+		question := fmt.Sprintf("Delete workspace '%s' (%d tasks)?", workspace.Name, len(workspace.Tasks))
+		if !skipConfirmation && !prompt.Confirm(question) {
+			styles.PrintInfo("Aborted.")
+			return
+		}
+
+		if err := repository.DeleteWorkspace(workspace.Name); err != nil {
+			styles.PrintError(fmt.Sprintf("Failed to delete workspace: %v", err))
+			os.Exit(1)
+		}
+		styles.PrintSuccess(fmt.Sprintf("Workspace '%s' deleted", workspace.Name))
 	},
 }
 
 // createWorkspaceCmd creates a new workspace
 var CreateCmd = &cobra.Command{
-	Use:   "create",
+	Use:   "create [name]",
 	Short: "Create a new workspace",
-	Long:  `Create a new workspace with selected tasks`,
+	Long: `Create a new workspace with selected tasks.
+Without a name an interactive form is opened; with a name the workspace is created
+from the --task flags, launched in the order given.`,
+	Example: `  vstr workspace create fullstack --task db --task api --task web`,
+	Args:    cobra.MaximumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
+		taskNames, _ := cmd.Flags().GetStringArray("task")
+
+		if len(args) == 1 {
+			if err := createWorkspaceFromFlags(args[0], taskNames); err != nil {
+				styles.PrintError(fmt.Sprintf("Failed to create workspace: %v", err))
+				os.Exit(1)
+			}
+			return
+		}
+
+		if len(taskNames) > 0 {
+			styles.PrintError("A workspace name is required when using --task")
+			os.Exit(1)
+		}
+
 		if err := CreateWorkspaceCommand(); err != nil {
 			styles.PrintError(fmt.Sprintf("Failed to create workspace: %v", err))
 		}
 	},
 }
+
+// AddTaskCmd appends tasks to a workspace
+var AddTaskCmd = &cobra.Command{
+	Use:   "add-task <workspace> <task>...",
+	Short: "Add tasks to a workspace",
+	Long:  `Append saved tasks to the end of a workspace's launch order`,
+	Args:  cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := addTasks(args[0], args[1:]); err != nil {
+			styles.PrintError(fmt.Sprintf("Failed to add tasks: %v", err))
+			os.Exit(1)
+		}
+	},
+}
+
+// RemoveTaskCmd removes tasks from a workspace
+var RemoveTaskCmd = &cobra.Command{
+	Use:   "remove-task <workspace> <task>...",
+	Short: "Remove tasks from a workspace",
+	Long:  `Remove tasks from a workspace. The tasks themselves are kept.`,
+	Args:  cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := removeTasks(args[0], args[1:]); err != nil {
+			styles.PrintError(fmt.Sprintf("Failed to remove tasks: %v", err))
+			os.Exit(1)
+		}
+	},
+}
+
+// MoveTaskCmd changes the launch position of a task in a workspace
+var MoveTaskCmd = &cobra.Command{
+	Use:   "move-task <workspace> <task> --to N",
+	Short: "Change the launch order of a task",
+	Long:  `Move a task to position N (1-based) in the workspace's launch order`,
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		position, _ := cmd.Flags().GetInt("to")
+
+		if err := moveTask(args[0], args[1], position); err != nil {
+			styles.PrintError(fmt.Sprintf("Failed to move task: %v", err))
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	ListCmd.Flags().BoolP("only-names", "n", false, "List only workspace names (same as --output names)")
+	DeleteCmd.Flags().BoolP("yes", "y", false, "Delete without asking for confirmation")
+	CreateCmd.Flags().StringArray("task", nil, "Task to include (repeatable, launched in order)")
+	MoveTaskCmd.Flags().Int("to", 0, "New position of the task (1-based)")
+	RunCmd.Flags().StringArrayP("tag", "t", nil, "Only run tasks with this tag (repeatable)")
+	MoveTaskCmd.MarkFlagRequired("to")
+
+	// Shell completion for saved names
+	RunCmd.ValidArgsFunction = completion.WorkspaceNames
+	RunCmd.RegisterFlagCompletionFunc("tag", completion.TagNames)
+	ShowCmd.ValidArgsFunction = completion.WorkspaceNames
+	EditCmd.ValidArgsFunction = completion.WorkspaceNames
+	RenameCmd.ValidArgsFunction = completion.WorkspaceNames
+	DeleteCmd.ValidArgsFunction = completion.WorkspaceNames
+	AddTaskCmd.ValidArgsFunction = completion.WorkspaceThenTasks
+	RemoveTaskCmd.ValidArgsFunction = completion.WorkspaceThenItsTasks
+	MoveTaskCmd.ValidArgsFunction = completion.WorkspaceThenOneTask
+}
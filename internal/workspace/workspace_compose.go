@@ -0,0 +1,70 @@
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/repository"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
+	"github.com/samber/lo"
+)
+
+// createWorkspaceFromFlags saves a workspace made of the named tasks, in the given order.
+func createWorkspaceFromFlags(name string, taskNames []string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return errors.New("workspace name is required")
+	}
+
+	if duplicates := lo.FindDuplicatesBy(taskNames, strings.ToLower); len(duplicates) > 0 {
+		return fmt.Errorf("task '%s' is listed more than once", duplicates[0])
+	}
+
+	tasks := make([]models.Task, 0, len(taskNames))
+	for _, taskName := range taskNames {
+		task, err := repository.FindTaskByName(taskName)
+		if err != nil {
+			return err
+		}
+		tasks = append(tasks, *task)
+	}
+
+	if err := repository.SaveWorkspace(models.Workspace{Name: name, Tasks: tasks}); err != nil {
+		return err
+	}
+
+	if len(tasks) == 0 {
+		styles.PrintWarning("No tasks given. Workspace will be empty.")
+	}
+	styles.PrintSuccess(fmt.Sprintf("Workspace '%s' created with %d task(s)", name, len(tasks)))
+	return nil
+}
+
+// addTasks appends tasks to a workspace and reports the result.
+func addTasks(workspaceName string, taskNames []string) error {
+	if err := repository.AddTasksToWorkspace(workspaceName, taskNames); err != nil {
+		return err
+	}
+	styles.PrintSuccess(fmt.Sprintf("Added %s to workspace '%s'", strings.Join(taskNames, ", "), workspaceName))
+	return nil
+}
+
+// removeTasks removes tasks from a workspace and reports the result.
+func removeTasks(workspaceName string, taskNames []string) error {
+	if err := repository.RemoveTasksFromWorkspace(workspaceName, taskNames); err != nil {
+		return err
+	}
+	styles.PrintSuccess(fmt.Sprintf("Removed %s from workspace '%s'", strings.Join(taskNames, ", "), workspaceName))
+	return nil
+}
+
+// moveTask changes the launch position of a task and reports the result.
+func moveTask(workspaceName, taskName string, position int) error {
+	if err := repository.MoveTaskInWorkspace(workspaceName, taskName, position); err != nil {
+		return err
+	}
+	styles.PrintSuccess(fmt.Sprintf("Moved '%s' to position %d in workspace '%s'", taskName, position, workspaceName))
+	return nil
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/DieGopherLT/vscode-terminal-runner/internal/repository"
-	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -35,7 +34,6 @@ func EditWorkspaceCommand(workspaceName string) error {
 	// Load the existing workspace
 	workspace, err := repository.FindWorkspaceByName(workspaceName)
 	if err != nil {
-		styles.PrintError(fmt.Sprintf("Workspace '%s' not found: %v", workspaceName, err))
 		return err
 	}
 	
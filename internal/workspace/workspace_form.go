@@ -28,6 +28,7 @@ type WorkspaceModel struct {
 	nameInput            textinput.Model
 	taskSelector         *components.TaskSelector
 	messages             *messages.MessageManager
+	validator            *tui.FormValidator
 	isEditMode           bool
 	originalWorkspaceName string
 }
@@ -70,14 +71,18 @@ func newWorkspaceModelInternal(workspace *models.Workspace) *WorkspaceModel {
 		taskSelector.SetSelectedTasks(workspace.Tasks)
 	}
 
-	return &WorkspaceModel{
+	model := &WorkspaceModel{
 		nav:                   nav,
 		nameInput:            nameInput,
 		taskSelector:         taskSelector,
 		messages:             messages.NewManager(),
+		validator:            tui.NewFormValidator(),
 		isEditMode:           isEditMode,
 		originalWorkspaceName: originalWorkspaceName,
 	}
+	model.validator.Register(nameField, model.validateName, nameInput.Value())
+
+	return model
 }
 
 // Init initializes the workspace form model.
@@ -87,11 +92,21 @@ func (w *WorkspaceModel) Init() tea.Cmd {
 
 // Update handles messages and updates the workspace form state.
 func (w *WorkspaceModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if w.validator.Update(msg, w.fieldValue) {
+		return w, nil
+	}
+
 	// Update the appropriate component based on focus first
 	if w.nav.FocusIndex == nameField {
+		before := w.nameInput.Value()
 		var cmd tea.Cmd
 		w.nameInput, cmd = w.nameInput.Update(msg)
 		w.clearMessagesOnInput()
+
+		// Validate the name once typing pauses
+		if w.nameInput.Value() != before {
+			cmd = tea.Batch(cmd, w.validator.Changed(nameField))
+		}
 		
 		// Check if this is a key message for navigation
 		if keyMsg, ok := msg.(tea.KeyMsg); ok {
@@ -176,6 +191,12 @@ func (w *WorkspaceModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "/":
 		return w.handleSearchToggle()
 
+	case "shift+up", "K":
+		return w.handleReorder(-1)
+
+	case "shift+down", "J":
+		return w.handleReorder(1)
+
 	case "ctrl+a":
 		return w.handleSelectAll()
 
@@ -199,12 +220,14 @@ func (w *WorkspaceModel) handleVerticalNavigation(key string) (tea.Model, tea.Cm
 	}
 
 	// Regular form navigation between fields
+	w.validateFocused()
 	w.nav.HandleNavigation(key)
 	return w.handleFocus()
 }
 
 // handleTabNavigation processes tab and shift+tab keys.
 func (w *WorkspaceModel) handleTabNavigation(key string) (tea.Model, tea.Cmd) {
+	w.validateFocused()
 	w.nav.HandleNavigation(key)
 	return w.handleFocus()
 }
@@ -228,6 +251,7 @@ func (w *WorkspaceModel) handleEnterKey() (tea.Model, tea.Cmd) {
 	}
 
 	// Move to next field
+	w.validateFocused()
 	w.nav.HandleNavigation("down")
 	return w.handleFocus()
 }
@@ -240,6 +264,14 @@ func (w *WorkspaceModel) handleSpaceKey() (tea.Model, tea.Cmd) {
 	return w, nil
 }
 
+// handleReorder moves the focused task earlier or later in the launch order.
+func (w *WorkspaceModel) handleReorder(direction int) (tea.Model, tea.Cmd) {
+	if w.nav.FocusIndex == taskListField && !w.taskSelector.IsInSearchMode() {
+		w.taskSelector.MoveSelected(direction)
+	}
+	return w, nil
+}
+
 // handleSearchToggle toggles search mode in task selector.
 func (w *WorkspaceModel) handleSearchToggle() (tea.Model, tea.Cmd) {
 	if w.nav.FocusIndex == taskListField {
@@ -282,6 +314,12 @@ func (w *WorkspaceModel) handleFocus() (tea.Model, tea.Cmd) {
 
 // handleSubmit processes workspace creation/update.
 func (w *WorkspaceModel) handleSubmit() (tea.Model, tea.Cmd) {
+	// Submit stays disabled until the name is valid, the problem shows under the field
+	if !w.validator.ValidateAll(w.fieldValue) {
+		w.nav.FocusIndex = nameField
+		return w.handleFocus()
+	}
+
 	workspace := w.createWorkspaceFromForm()
 
 	if !w.isValidWorkspace(workspace) {
@@ -314,18 +352,13 @@ func (w *WorkspaceModel) createWorkspaceFromForm() models.Workspace {
 func (w *WorkspaceModel) isValidWorkspace(workspace models.Workspace) bool {
 	w.messages.Clear()
 
-	if workspace.Name == "" {
-		w.messages.AddError("Workspace name is required")
+	if problem := w.validateName(workspace.Name); problem != "" {
+		w.messages.AddError(problem)
 		w.nav.FocusIndex = nameField
 		w.handleFocus()
 		return false
 	}
 
-	// Check for duplicate workspace names
-	if !w.isValidWorkspaceName(workspace.Name) {
-		return false
-	}
-
 	if len(workspace.Tasks) == 0 {
 		w.messages.AddWarning("No tasks selected. Workspace will be empty.")
 		// Allow empty workspaces but warn user
@@ -337,12 +370,7 @@ func (w *WorkspaceModel) isValidWorkspace(workspace models.Workspace) bool {
 // saveWorkspace saves the workspace to the repository.
 func (w *WorkspaceModel) saveWorkspace(workspace models.Workspace) error {
 	if w.isEditMode {
-		// Delete old workspace if name changed
-		if workspace.Name != w.originalWorkspaceName {
-			if err := repository.DeleteWorkspace(w.originalWorkspaceName); err != nil {
-				return fmt.Errorf("failed to delete old workspace: %w", err)
-			}
-		}
+		return repository.UpdateWorkspace(w.originalWorkspaceName, workspace)
 	}
 
 	return repository.SaveWorkspace(workspace)
@@ -372,6 +400,9 @@ func (w *WorkspaceModel) View() string {
 		styles.FieldLabelStyle.Render("Workspace Name:"),
 		w.nameInput.View(),
 	)
+	if problem := w.validator.RenderProblem(nameField); problem != "" {
+		nameFieldContent = lipgloss.JoinVertical(lipgloss.Left, nameFieldContent, problem)
+	}
 	sections = append(sections, styles.FieldContainerStyle.Render(nameFieldContent))
 
 	// Task selector field
@@ -384,7 +415,9 @@ func (w *WorkspaceModel) View() string {
 
 	// Submit button
 	button := styles.RenderBlurredButton("Submit")
-	if w.nav.FocusIndex >= w.nav.GetElementCount() {
+	if !w.validator.Valid() {
+		button = styles.RenderDisabledButton("Submit")
+	} else if w.nav.FocusIndex >= w.nav.GetElementCount() {
 		button = styles.RenderFocusedButton("Submit")
 	}
 	sections = append(sections, button)
@@ -421,21 +454,36 @@ func getAvailableTasks() []models.Task {
 	return availableTasks
 }
 
-// isValidWorkspaceName checks if workspace name is valid and available using guard clauses.
-func (w *WorkspaceModel) isValidWorkspaceName(workspaceName string) bool {
+// validateName returns the problem with a workspace name: missing, or taken by another workspace.
+func (w *WorkspaceModel) validateName(workspaceName string) string {
+	workspaceName = strings.TrimSpace(workspaceName)
+	if workspaceName == "" {
+		return "Workspace name is required"
+	}
+
 	// Skip validation if editing with same name
-	isEditingWithSameName := w.isEditMode && workspaceName == w.originalWorkspaceName
+	isEditingWithSameName := w.isEditMode && strings.EqualFold(workspaceName, w.originalWorkspaceName)
 	if isEditingWithSameName {
-		return true
+		return ""
 	}
-	
-	_, err := repository.FindWorkspaceByName(workspaceName)
-	if err == nil {
-		w.messages.AddError("Workspace name already exists")
-		w.nav.FocusIndex = nameField
-		w.handleFocus()
-		return false
+
+	if _, err := repository.FindWorkspaceByName(workspaceName); err == nil {
+		return "Workspace name already exists"
 	}
-	
-	return true
+	return ""
+}
+
+// validateFocused validates the focused field as it loses focus.
+func (w *WorkspaceModel) validateFocused() {
+	if w.nav.FocusIndex == nameField {
+		w.validator.Validate(nameField, w.nameInput.Value())
+	}
+}
+
+// fieldValue returns the value of a validated form field.
+func (w *WorkspaceModel) fieldValue(field int) string {
+	if field == nameField {
+		return w.nameInput.Value()
+	}
+	return ""
 }
\ No newline at end of file
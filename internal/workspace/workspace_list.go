@@ -0,0 +1,88 @@
+package workspace
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/repository"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/output"
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
+	"github.com/samber/lo"
+)
+
+// listAllWorkspaces prints every saved workspace in the format chosen with --output.
+func listAllWorkspaces() error {
+	workspaces, err := repository.ReadWorkspaces()
+	if err != nil {
+		return err
+	}
+
+	if !output.IsTable() {
+		names := lo.Map(workspaces, func(ws models.Workspace, _ int) string { return ws.Name })
+		return output.Print(normalizeWorkspaces(workspaces), names)
+	}
+
+	if len(workspaces) == 0 {
+		fmt.Println("No workspaces found.")
+		return nil
+	}
+
+	var strBuilder strings.Builder
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	defer writer.Flush()
+
+	strBuilder.WriteString("Name\tTasks\tTask Names\n")
+	for _, workspace := range workspaces {
+		strBuilder.WriteString(workspace.Name + "\t")
+		strBuilder.WriteString(fmt.Sprintf("%d\t", len(workspace.Tasks)))
+		strBuilder.WriteString(strings.Join(workspaceTaskNames(workspace), ", ") + "\n")
+	}
+	fmt.Fprintln(writer, strBuilder.String())
+	return nil
+}
+
+// showWorkspace prints the details of a workspace and every task it launches.
+// The names format lists the workspace's tasks in launch order.
+func showWorkspace(name string) error {
+	workspace, err := repository.FindWorkspaceByName(name)
+	if err != nil {
+		return err
+	}
+
+	if !output.IsTable() {
+		return output.Print(normalizeWorkspaces([]models.Workspace{*workspace})[0], workspaceTaskNames(*workspace))
+	}
+
+	fmt.Println(styles.RunnerHeaderStyle.Render("WORKSPACE: " + workspace.Name))
+	fmt.Println(styles.RunnerInfoStyle.Render(fmt.Sprintf("Tasks: %d", len(workspace.Tasks))))
+	fmt.Println()
+
+	for i, task := range workspace.Tasks {
+		fmt.Printf("  %d. %s %s\n", i+1, task.Icon, styles.RunnerTaskNameStyle.Render(task.Name))
+		fmt.Println(styles.RunnerInfoStyle.Render(fmt.Sprintf("   Path: %s", task.Path)))
+		for _, cmd := range task.Cmds {
+			fmt.Println(styles.RunnerInfoStyle.Render(fmt.Sprintf("   $ %s", cmd)))
+		}
+	}
+	return nil
+}
+
+// normalizeWorkspaces replaces nil slices with empty ones so JSON output never contains null lists.
+func normalizeWorkspaces(workspaces []models.Workspace) []models.Workspace {
+	normalized := lo.Map(workspaces, func(ws models.Workspace, _ int) models.Workspace {
+		ws.Tasks = lo.Map(ws.Tasks, func(task models.Task, _ int) models.Task {
+			task.Cmds = lo.Ternary(task.Cmds == nil, []string{}, task.Cmds)
+			return task
+		})
+		return ws
+	})
+	return normalized
+}
+
+// workspaceTaskNames returns the names of the workspace's tasks in launch order.
+func workspaceTaskNames(workspace models.Workspace) []string {
+	return lo.Map(workspace.Tasks, func(task models.Task, _ int) string { return task.Name })
+}
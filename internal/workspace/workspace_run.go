@@ -0,0 +1,47 @@
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/models"
+	"github.com/DieGopherLT/vscode-terminal-runner/internal/repository"
+	"github.com/samber/lo"
+)
+
+// resolveRunWorkspace returns the workspace to launch: a saved workspace, optionally narrowed to
+// the tasks carrying any of tags, or a workspace built from every task carrying any of tags.
+func resolveRunWorkspace(args, tags []string) (*models.Workspace, error) {
+	if len(args) == 0 && len(tags) == 0 {
+		return nil, errors.New("pass a workspace name, --tag, or both")
+	}
+
+	tagLabel := "#" + strings.Join(tags, " #")
+
+	if len(args) == 0 {
+		tasks, err := repository.MatchTasksByTags(tags)
+		if err != nil {
+			return nil, err
+		}
+		return &models.Workspace{Name: tagLabel, Tasks: tasks}, nil
+	}
+
+	workspace, err := repository.FindWorkspaceByName(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("workspace not found: %w", err)
+	}
+
+	if len(tags) == 0 {
+		return workspace, nil
+	}
+
+	tasks := lo.Filter(workspace.Tasks, func(task models.Task, _ int) bool {
+		return lo.SomeBy(tags, func(tag string) bool { return repository.HasTag(task, tag) })
+	})
+	if len(tasks) == 0 {
+		return nil, fmt.Errorf("no task in workspace '%s' is tagged %s", workspace.Name, tagLabel)
+	}
+
+	return &models.Workspace{Name: workspace.Name + " " + tagLabel, Tasks: tasks}, nil
+}
@@ -0,0 +1,110 @@
+// Package output renders command results as tables or machine-readable formats.
+// The JSON and YAML shapes are documented in docs/OUTPUT.md.
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/samber/lo"
+	"gopkg.in/yaml.v3"
+)
+
+// Format selects how list and show commands print their results.
+type Format string
+
+const (
+	Table Format = "table" // Human-readable tables (default)
+	JSON  Format = "json"  // Indented JSON, stable field names
+	YAML  Format = "yaml"  // YAML with the same shape as JSON
+	Names Format = "names" // One name per line, nothing else
+)
+
+// Formats lists every supported output format.
+var Formats = []Format{Table, JSON, YAML, Names}
+
+// current is the format chosen with the global --output flag.
+var current = Table
+
+// ParseFormat converts a flag value into a Format.
+func ParseFormat(value string) (Format, error) {
+	format := Format(strings.ToLower(strings.TrimSpace(value)))
+	if !lo.Contains(Formats, format) {
+		return "", fmt.Errorf("invalid output format '%s' (expected table, json, yaml or names)", value)
+	}
+	return format, nil
+}
+
+// SetFormat changes the format used by Print.
+func SetFormat(format Format) {
+	current = format
+}
+
+// Current returns the format chosen with the global --output flag.
+func Current() Format {
+	return current
+}
+
+// IsTable reports whether results should be printed for humans.
+func IsTable() bool {
+	return current == Table
+}
+
+// Print writes value to stdout in the current format. names is printed for the names format.
+func Print(value any, names []string) error {
+	return Write(os.Stdout, current, value, names)
+}
+
+// Write renders value in a machine-readable format. Table output is left to the caller.
+func Write(w io.Writer, format Format, value any, names []string) error {
+	switch format {
+	case JSON:
+		return writeJSON(w, value)
+	case YAML:
+		return writeYAML(w, value)
+	case Names:
+		for _, name := range names {
+			if _, err := fmt.Fprintln(w, name); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("output format '%s' must be rendered by the caller", format)
+	}
+}
+
+// writeJSON encodes value as indented JSON without HTML escaping, so commands stay readable.
+func writeJSON(w io.Writer, value any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
+
+// writeYAML encodes value as YAML, keeping the JSON field names and order.
+func writeYAML(w io.Writer, value any) error {
+	var buf bytes.Buffer
+	if err := writeJSON(&buf, value); err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(&buf)
+	decoder.UseNumber()
+
+	root, err := decodeYAMLNode(decoder)
+	if err != nil {
+		return err
+	}
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(root); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
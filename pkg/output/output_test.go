@@ -0,0 +1,140 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+type testTask struct {
+	Name string   `json:"name"`
+	Path string   `json:"path"`
+	Cmds []string `json:"cmds"`
+}
+
+type testWorkspace struct {
+	Name  string     `json:"name"`
+	Tasks []testTask `json:"tasks"`
+}
+
+func TestWriteYAML(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		expected string
+	}{
+		{
+			name:     "empty list",
+			value:    []testTask{},
+			expected: "[]\n",
+		},
+		{
+			name:  "list of objects keeps field order",
+			value: []testTask{{Name: "api", Path: "~/src/api", Cmds: []string{"go run ."}}},
+			expected: "- name: api\n" +
+				"  path: ~/src/api\n" +
+				"  cmds:\n" +
+				"    - go run .\n",
+		},
+		{
+			name:  "nested objects and empty values",
+			value: testWorkspace{Name: "dev", Tasks: []testTask{{Name: "web", Cmds: []string{}}}},
+			expected: "name: dev\n" +
+				"tasks:\n" +
+				"  - name: web\n" +
+				"    path: \"\"\n" +
+				"    cmds: []\n",
+		},
+		{
+			name:  "ambiguous strings are quoted",
+			value: []string{"yes", "8080", "- item", "key: value", "echo \"hi\"", "terminal.ansiGreen"},
+			expected: "- \"yes\"\n" +
+				"- \"8080\"\n" +
+				"- '- item'\n" +
+				"- 'key: value'\n" +
+				"- echo \"hi\"\n" +
+				"- terminal.ansiGreen\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var buf bytes.Buffer
+
+			// Act
+			err := Write(&buf, YAML, tt.value, nil)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if buf.String() != tt.expected {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expected, buf.String())
+			}
+		})
+	}
+}
+
+func TestWriteYAML_RoundTrip(t *testing.T) {
+	// Arrange
+	values := []string{
+		"0x1F", "0o17", "017", ".inf", "-.Inf", ".nan", "2001-12-14", "2001-12-14T21:59:43Z",
+		"1e3", "1_000", "+12", "true", "Null", "~", "", " padded ", "yes", "multi\nline", "#comment", "&anchor", "*alias",
+	}
+	var buf bytes.Buffer
+
+	// Act
+	err := Write(&buf, YAML, values, nil)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var decoded []any
+	if err := yaml.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("failed to parse the YAML output: %v\n%s", err, buf.String())
+	}
+	if len(decoded) != len(values) {
+		t.Fatalf("expected %d values, got %d", len(values), len(decoded))
+	}
+	for i, value := range values {
+		if decoded[i] != value {
+			t.Errorf("expected %q to read back as the same string, got %#v", value, decoded[i])
+		}
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected Format
+		wantErr  bool
+	}{
+		{value: "json", expected: JSON},
+		{value: " YAML ", expected: YAML},
+		{value: "names", expected: Names},
+		{value: "table", expected: Table},
+		{value: "xml", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			// Act
+			format, err := ParseFormat(tt.value)
+
+			// Assert
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error for %q", tt.value)
+				}
+				return
+			}
+			if format != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, format)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+	"gopkg.in/yaml.v3"
+)
+
+// decodeYAMLNode reads the next JSON value from decoder as a YAML node, keeping the order of object keys
+// so YAML has the same shape and field order as the JSON output.
+func decodeYAMLNode(decoder *json.Decoder) (*yaml.Node, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	delim, isDelim := token.(json.Delim)
+	if !isDelim {
+		return scalarNode(token)
+	}
+
+	node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+	if delim == '{' {
+		node = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	}
+
+	for decoder.More() {
+		if node.Kind == yaml.MappingNode {
+			key, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key.(string)})
+		}
+
+		child, err := decodeYAMLNode(decoder)
+		if err != nil {
+			return nil, err
+		}
+		node.Content = append(node.Content, child)
+	}
+
+	// Consume the closing delimiter
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+
+	// Empty containers stay on one line, as [] and {}
+	if len(node.Content) == 0 {
+		node.Style = yaml.FlowStyle
+	}
+	return node, nil
+}
+
+// scalarNode converts a JSON scalar token into a tagged YAML scalar. Strings are tagged !!str,
+// so the encoder quotes any value a parser would read back as another type.
+func scalarNode(token json.Token) (*yaml.Node, error) {
+	node := &yaml.Node{Kind: yaml.ScalarNode}
+
+	switch value := token.(type) {
+	case nil:
+		node.Tag, node.Value = "!!null", "null"
+	case bool:
+		node.Tag, node.Value = "!!bool", fmt.Sprint(value)
+	case json.Number:
+		node.Tag, node.Value = "!!int", value.String()
+		if strings.ContainsAny(node.Value, ".eE") {
+			node.Tag = "!!float"
+		}
+	case string:
+		node.Tag, node.Value = "!!str", value
+		if isYAML11Bool(value) {
+			node.Style = yaml.DoubleQuotedStyle
+		}
+	default:
+		return nil, fmt.Errorf("unexpected JSON token %v", token)
+	}
+	return node, nil
+}
+
+// yaml11Bools are read as booleans by YAML 1.1 parsers. The encoder follows YAML 1.2 and leaves them plain.
+var yaml11Bools = []string{"y", "yes", "n", "no", "on", "off"}
+
+// isYAML11Bool reports whether a YAML 1.1 parser would read the plain string s as a boolean.
+func isYAML11Bool(s string) bool {
+	return lo.ContainsBy(yaml11Bools, func(b string) bool { return strings.EqualFold(s, b) })
+}
@@ -0,0 +1,40 @@
+// Package prompt provides simple line-based questions for non-TUI commands.
+package prompt
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Confirm asks a yes/no question that defaults to "no" and reports whether the user agreed.
+func Confirm(question string) bool {
+	answer := Ask(question, "[y/N]: ")
+	return answer == "y" || answer == "yes"
+}
+
+// Ask prints a styled question followed by the given options hint and returns the lowercased answer.
+func Ask(question, options string) string {
+	promptStyle := lipgloss.NewStyle().
+		Foreground(styles.VSCodeBlue).
+		Bold(true)
+
+	optionsStyle := lipgloss.NewStyle().
+		Foreground(styles.LightGray)
+
+	fmt.Print(promptStyle.Render(question))
+	fmt.Print(" ")
+	fmt.Print(optionsStyle.Render(options))
+
+	reader := bufio.NewReader(os.Stdin)
+	answer, err := reader.ReadString('\n')
+	if err != nil && answer == "" {
+		return ""
+	}
+
+	return strings.ToLower(strings.TrimSpace(answer))
+}
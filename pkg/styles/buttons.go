@@ -17,6 +17,13 @@ var (
 			BorderForeground(LightGray).
 			Padding(0, 2)
 
+	// Disabled button, while the form is not valid
+	DisabledButton = lipgloss.NewStyle().
+			Foreground(DarkGray).
+			Border(lipgloss.NormalBorder()).
+			BorderForeground(DarkGray).
+			Padding(0, 2)
+
 	// Danger button for destructive actions
 	DangerButton = lipgloss.NewStyle().
 			Foreground(White).
@@ -40,6 +47,11 @@ func RenderBlurredButton(text string) string {
 	return BlurredButton.Render(text)
 }
 
+// Renders a button that cannot be pressed yet
+func RenderDisabledButton(text string) string {
+	return DisabledButton.Render(text)
+}
+
 func RenderDangerButton(text string) string {
 	return DangerButton.Render(text)
 }
@@ -0,0 +1,46 @@
+package styles
+
+import "github.com/charmbracelet/lipgloss"
+
+// Dashboard styles
+var (
+	// Tab styles
+	ActiveTabStyle = lipgloss.NewStyle().
+			Foreground(White).
+			Background(VSCodeBlue).
+			Bold(true).
+			Padding(0, 2)
+
+	InactiveTabStyle = lipgloss.NewStyle().
+				Foreground(LightGray).
+				Padding(0, 2)
+
+	// Pane styles
+	ListPaneStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(GrayBlue).
+			Padding(0, 1).
+			Width(40).
+			Height(14)
+
+	DetailPaneStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(DarkGray).
+			Padding(0, 1).
+			Width(56).
+			Height(14)
+
+	// Confirmation modal for destructive actions
+	ModalStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(Error).
+			Padding(1, 2).
+			Width(60)
+
+	// Bridge status footer
+	BridgeConnectedStyle = lipgloss.NewStyle().
+				Foreground(Success)
+
+	BridgeDisconnectedStyle = lipgloss.NewStyle().
+				Foreground(Warning)
+)
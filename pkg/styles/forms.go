@@ -20,6 +20,10 @@ var (
 			Foreground(GrayBlue).
 			Bold(true)
 
+	// Inline error rendered under an invalid field
+	FieldErrorStyle = lipgloss.NewStyle().
+			Foreground(Error)
+
 	// Container styles
 	FieldContainerStyle = lipgloss.NewStyle().
 				MarginBottom(0)
@@ -52,6 +56,12 @@ var (
 					Bold(true).
 					PaddingLeft(1)
 
+	// Characters of a suggestion matched by the typed input
+	SuggestionMatchStyle = lipgloss.NewStyle().
+				Foreground(LightBlue).
+				Bold(true).
+				Underline(true)
+
 	// Title styles
 	TitleStyle = lipgloss.NewStyle().
 			Foreground(VSCodeBlue).
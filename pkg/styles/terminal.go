@@ -1,5 +1,7 @@
 package styles
 
+import "github.com/charmbracelet/lipgloss"
+
 type VSCodeIcon struct {
 	Name string
 	Desc string
@@ -531,8 +533,9 @@ var VSCodeIcons = []VSCodeIcon{
 }
 
 type VSCodeANSIColor struct {
-	Name string
-	Desc string
+	Name  string
+	Desc  string
+	Color lipgloss.Color // ANSI color index used to preview the color in the terminal
 }
 
 func (c VSCodeANSIColor) Title() string       { return c.Name }
@@ -540,20 +543,49 @@ func (c VSCodeANSIColor) Description() string { return c.Desc }
 func (c VSCodeANSIColor) FilterValue() string { return c.Name }
 
 var VSCodeANSIColors = []VSCodeANSIColor{
-	{Name: "terminal.ansiBlack", Desc: "Black"},
-	{Name: "terminal.ansiRed", Desc: "Red"},
-	{Name: "terminal.ansiGreen", Desc: "Green"},
-	{Name: "terminal.ansiYellow", Desc: "Yellow"},
-	{Name: "terminal.ansiBlue", Desc: "Blue"},
-	{Name: "terminal.ansiMagenta", Desc: "Magenta"},
-	{Name: "terminal.ansiCyan", Desc: "Cyan"},
-	{Name: "terminal.ansiWhite", Desc: "White"},
-	{Name: "terminal.ansiBrightBlack", Desc: "Bright Black"},
-	{Name: "terminal.ansiBrightRed", Desc: "Bright Red"},
-	{Name: "terminal.ansiBrightGreen", Desc: "Bright Green"},
-	{Name: "terminal.ansiBrightYellow", Desc: "Bright Yellow"},
-	{Name: "terminal.ansiBrightBlue", Desc: "Bright Blue"},
-	{Name: "terminal.ansiBrightMagenta", Desc: "Bright Magenta"},
-	{Name: "terminal.ansiBrightCyan", Desc: "Bright Cyan"},
-	{Name: "terminal.ansiBrightWhite", Desc: "Bright White"},
+	{Name: "terminal.ansiBlack", Desc: "Black", Color: lipgloss.Color("0")},
+	{Name: "terminal.ansiRed", Desc: "Red", Color: lipgloss.Color("1")},
+	{Name: "terminal.ansiGreen", Desc: "Green", Color: lipgloss.Color("2")},
+	{Name: "terminal.ansiYellow", Desc: "Yellow", Color: lipgloss.Color("3")},
+	{Name: "terminal.ansiBlue", Desc: "Blue", Color: lipgloss.Color("4")},
+	{Name: "terminal.ansiMagenta", Desc: "Magenta", Color: lipgloss.Color("5")},
+	{Name: "terminal.ansiCyan", Desc: "Cyan", Color: lipgloss.Color("6")},
+	{Name: "terminal.ansiWhite", Desc: "White", Color: lipgloss.Color("7")},
+	{Name: "terminal.ansiBrightBlack", Desc: "Bright Black", Color: lipgloss.Color("8")},
+	{Name: "terminal.ansiBrightRed", Desc: "Bright Red", Color: lipgloss.Color("9")},
+	{Name: "terminal.ansiBrightGreen", Desc: "Bright Green", Color: lipgloss.Color("10")},
+	{Name: "terminal.ansiBrightYellow", Desc: "Bright Yellow", Color: lipgloss.Color("11")},
+	{Name: "terminal.ansiBrightBlue", Desc: "Bright Blue", Color: lipgloss.Color("12")},
+	{Name: "terminal.ansiBrightMagenta", Desc: "Bright Magenta", Color: lipgloss.Color("13")},
+	{Name: "terminal.ansiBrightCyan", Desc: "Bright Cyan", Color: lipgloss.Color("14")},
+	{Name: "terminal.ansiBrightWhite", Desc: "Bright White", Color: lipgloss.Color("15")},
+}
+
+// FindIcon returns the icon with the given name.
+func FindIcon(name string) (VSCodeIcon, bool) {
+	for _, icon := range VSCodeIcons {
+		if icon.Name == name {
+			return icon, true
+		}
+	}
+	return VSCodeIcon{}, false
+}
+
+// FindANSIColor returns the ANSI color with the given name.
+func FindANSIColor(name string) (VSCodeANSIColor, bool) {
+	for _, color := range VSCodeANSIColors {
+		if color.Name == name {
+			return color, true
+		}
+	}
+	return VSCodeANSIColor{}, false
+}
+
+// RenderColorSwatch renders a block in the given ANSI color, or an empty box for unknown colors.
+func RenderColorSwatch(name string) string {
+	color, found := FindANSIColor(name)
+	if !found {
+		return LightGrayStyle.Render("░░")
+	}
+	return lipgloss.NewStyle().Foreground(color.Color).Render("██")
 }
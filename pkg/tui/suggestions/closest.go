@@ -0,0 +1,63 @@
+package suggestions
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Closest returns the candidate the input most likely meant, for "did you mean" hints.
+// A candidate within a few typos of the input wins (case-insensitive), otherwise the best FuzzyScore match.
+// It returns false when nothing is close.
+func Closest(candidates []string, input string) (string, bool) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return "", false
+	}
+
+	// Allow one typo every three characters, at least one
+	maxDistance := max(1, utf8.RuneCountInString(input)/3)
+
+	closest, closestDistance := "", maxDistance+1
+	for _, candidate := range candidates {
+		if distance := editDistance(strings.ToLower(candidate), strings.ToLower(input)); distance < closestDistance {
+			closest, closestDistance = candidate, distance
+		}
+	}
+	if closest != "" {
+		return closest, true
+	}
+
+	bestScore := noScore
+	for _, candidate := range candidates {
+		score, _, matched := FuzzyScore(candidate, input)
+		if matched && (score > bestScore || score == bestScore && len(candidate) < len(closest)) {
+			closest, bestScore = candidate, score
+		}
+	}
+	return closest, closest != ""
+}
+
+// editDistance returns the Levenshtein distance between a and b: the insertions, deletions
+// and substitutions of characters needed to turn one into the other.
+func editDistance(a, b string) int {
+	source, target := []rune(a), []rune(b)
+
+	previous := make([]int, len(target)+1)
+	current := make([]int, len(target)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(source); i++ {
+		current[0] = i
+		for j := 1; j <= len(target); j++ {
+			substitution := previous[j-1]
+			if source[i-1] != target[j-1] {
+				substitution++
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, substitution)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(target)]
+}
@@ -2,7 +2,14 @@
 //
 // The main component is Manager, which handles filtering, navigation,
 // and rendering of suggestions. It supports predefined filter functions
-// for common use cases.
+// for common use cases, and scoring functions such as FuzzyScore that rank
+// the suggestions and highlight the matched characters.
+//
+// Suggestions come from a Provider. Fixed lists use StaticProvider and are
+// available right away; other providers, such as the directory listing of
+// PathManager, load in the background: Query returns a tea.Cmd whose LoadedMsg
+// is passed back to Update. Results are cached per provider key, a newer query
+// cancels a load in flight, and Render shows a loading line meanwhile.
 //
 // Example usage:
 //
@@ -12,7 +19,15 @@
 //		suggestions.StartsWithFilter,
 //	)
 //
-//	// In your Update method:
+//	// Or rank by fuzzy match, best first:
+//	iconSuggestions = suggestions.NewScoringManager(iconNames, 3, suggestions.FuzzyScore)
+//
+//	// Optionally move frequently used suggestions up the list:
+//	iconSuggestions.SetBoostFunc(func(name string) int { return usage[name] })
+//
+//	// In your Update method, query as the input changes and hand back loaded results:
+//	cmd := pathSuggestions.Query(input.Value())
+//	pathSuggestions.Update(msg) // for suggestions.LoadedMsg
 //	iconSuggestions.Next()  // Navigate to next suggestion
 //	iconSuggestions.ApplySelected(&textInput)  // Apply selection
 //
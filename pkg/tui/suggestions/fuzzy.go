@@ -0,0 +1,127 @@
+package suggestions
+
+import (
+	"math"
+	"unicode"
+)
+
+// ScoreFunc scores how well a suggestion matches the input, for managers that rank their results.
+// It returns false when the suggestion does not match at all, and the rune indexes of the suggestion
+// that matched the input, which Render highlights.
+type ScoreFunc func(suggestion, input string) (score int, positions []int, matched bool)
+
+// Scoring weights of FuzzyScore
+const (
+	scoreMatch       = 16 // Every matched character
+	bonusPrefix      = 12 // The first input character matches the first character of the suggestion
+	bonusBoundary    = 8  // A character starts a word: after a separator or a lower to upper case change
+	bonusConsecutive = 6  // A character directly follows the previous match
+	penaltyGapStart  = 3  // Skipping characters between two matches
+	penaltyGapExtend = 1  // Every further skipped character
+)
+
+// FuzzyScore is an fzf-style ScoreFunc (case-insensitive): the input characters must appear
+// in the suggestion in order. Matches at the start of the suggestion, at word starts and in
+// consecutive runs score higher, gaps between matched characters score lower,
+// so "bash" ranks "terminal-bash" above names where those letters are scattered.
+var FuzzyScore ScoreFunc = func(suggestion, input string) (int, []int, bool) {
+	text := []rune(suggestion)
+	pattern := []rune(input)
+	if len(pattern) == 0 {
+		return 0, nil, true
+	}
+	if len(pattern) > len(text) {
+		return 0, nil, false
+	}
+
+	return bestAlignment(text, pattern)
+}
+
+// noScore marks a pattern character that cannot be matched at a text position
+const noScore = math.MinInt / 2
+
+// bestAlignment finds the highest scoring way to match pattern in text, in order, with dynamic programming.
+// score[i][j] is the best score of pattern[:i+1] with pattern[i] matched at text[j], and from[i][j]
+// the position of pattern[i-1] in that alignment, used to recover the matched positions.
+func bestAlignment(text, pattern []rune) (int, []int, bool) {
+	score := make([][]int, len(pattern))
+	from := make([][]int, len(pattern))
+
+	for i := range pattern {
+		score[i] = make([]int, len(text))
+		from[i] = make([]int, len(text))
+
+		// Best alignment of pattern[:i] ending before j-1, already charged with the gap up to j
+		bestGapped, bestGappedAt := noScore, -1
+
+		for j := range text {
+			if i > 0 {
+				if bestGapped != noScore {
+					bestGapped -= penaltyGapExtend
+				}
+				if j >= 2 && score[i-1][j-2] != noScore && score[i-1][j-2]-penaltyGapStart > bestGapped {
+					bestGapped, bestGappedAt = score[i-1][j-2]-penaltyGapStart, j-2
+				}
+			}
+
+			score[i][j] = noScore
+			if !equalFold(text[j], pattern[i]) {
+				continue
+			}
+
+			characterScore := scoreMatch + characterBonus(text, j)
+			if i == 0 {
+				score[i][j], from[i][j] = characterScore, -1
+				continue
+			}
+
+			best, bestFrom := bestGapped, bestGappedAt
+			if j >= 1 && score[i-1][j-1] != noScore && score[i-1][j-1]+bonusConsecutive >= best {
+				best, bestFrom = score[i-1][j-1]+bonusConsecutive, j-1
+			}
+			if best != noScore {
+				score[i][j], from[i][j] = best+characterScore, bestFrom
+			}
+		}
+	}
+
+	last := len(pattern) - 1
+	end := -1
+	for j := range text {
+		if score[last][j] != noScore && (end == -1 || score[last][j] > score[last][end]) {
+			end = j
+		}
+	}
+	if end == -1 {
+		return 0, nil, false
+	}
+
+	positions := make([]int, len(pattern))
+	for i, j := last, end; i >= 0; i-- {
+		positions[i] = j
+		j = from[i][j]
+	}
+
+	return score[last][end], positions, true
+}
+
+// characterBonus rewards a match on the first character or on a word start.
+func characterBonus(text []rune, position int) int {
+	if position == 0 {
+		return bonusPrefix
+	}
+
+	previous, current := text[position-1], text[position]
+	if !unicode.IsLetter(previous) && !unicode.IsDigit(previous) {
+		return bonusBoundary
+	}
+	if unicode.IsLower(previous) && unicode.IsUpper(current) {
+		return bonusBoundary
+	}
+	return 0
+}
+
+// equalFold compares two runes ignoring case.
+func equalFold(a, b rune) bool {
+	return unicode.ToLower(a) == unicode.ToLower(b)
+}
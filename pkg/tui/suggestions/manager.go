@@ -1,6 +1,11 @@
 package suggestions
 
 import (
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
 	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
 	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/lipgloss"
@@ -10,15 +15,38 @@ import (
 // FilterFunc defines how suggestions are filtered based on input
 type FilterFunc func(suggestion, input string) bool
 
+// DescribeFunc returns extra text rendered next to a suggestion, such as a description or a color swatch
+type DescribeFunc func(suggestion string) string
+
+// BoostFunc returns a bonus added to the rank of a suggestion, such as how often the user picked it
+type BoostFunc func(suggestion string) int
+
+// loadingText is rendered while the suggestions of the input are loading
+const loadingText = "⋯ loading suggestions"
+
+// suggestionLineWidth is the widest rendered suggestion line, matching SuggestionContainerStyle
+const suggestionLineWidth = 56
+
 // Manager handles autocomplete suggestions with navigation and rendering
 type Manager struct {
-	allSuggestions      []string   // All available suggestions
-	filteredSuggestions []string   // Current filtered suggestions
-	selectedIndex       int        // Currently selected suggestion index
-	maxVisible          int        // Maximum suggestions to show
-	filterFunc          FilterFunc // Function to filter suggestions
-	lastInput           string     // Last input used for filtering
-	showOnEmpty         bool       // Whether to show suggestions when input is empty
+	allSuggestions      []string              // All available suggestions
+	filteredSuggestions []string              // Current filtered suggestions
+	selectedIndex       int                   // Currently selected suggestion index
+	maxVisible          int                   // Maximum suggestions to show
+	filterFunc          FilterFunc            // Function to filter suggestions
+	lastInput           string                // Last input used for filtering
+	showOnEmpty         bool                  // Whether to show suggestions when input is empty
+	describeFunc        DescribeFunc          // Optional text rendered next to each suggestion
+	scoreFunc           ScoreFunc             // Ranks the suggestions instead of filterFunc when set
+	matchPositions      map[string][]int      // Matched rune indexes of each filtered suggestion, when scored
+	boostFunc           BoostFunc             // Optional bonus moving suggestions up the list
+	extraSuggestions    []string              // Offered with the loaded suggestions whatever the input
+	provider            Provider              // Source of the suggestions
+	cache               map[string]cacheEntry // Loaded suggestions by provider key
+	cacheTTL            time.Duration         // How long cached suggestions are fresh, zero for ever
+	activeKey           string                // Provider key of the last query
+	pending             *pendingQuery         // Load in flight, nil when idle
+	queries             int                   // Number of loads started, identifies the latest one
 }
 
 // NewManager creates a new suggestion manager (shows suggestions on empty input by default)
@@ -28,26 +56,53 @@ func NewManager(suggestions []string, maxVisible int, filterFunc FilterFunc) *Ma
 
 // NewManagerWithOptions creates a new suggestion manager with custom options
 func NewManagerWithOptions(suggestions []string, maxVisible int, filterFunc FilterFunc, showOnEmpty bool) *Manager {
+	return NewProviderManager(StaticProvider(suggestions), maxVisible, filterFunc, showOnEmpty)
+}
+
+// NewProviderManager creates a suggestion manager whose suggestions are loaded from provider by Query
+func NewProviderManager(provider Provider, maxVisible int, filterFunc FilterFunc, showOnEmpty bool) *Manager {
 	if filterFunc == nil {
 		filterFunc = StartsWithFilter
 	}
 
-	return &Manager{
-		allSuggestions:      suggestions,
-		filteredSuggestions: suggestions,
-		selectedIndex:       0,
-		maxVisible:          maxVisible,
-		filterFunc:          filterFunc,
-		showOnEmpty:         showOnEmpty,
+	manager := &Manager{
+		selectedIndex: 0,
+		maxVisible:    maxVisible,
+		filterFunc:    filterFunc,
+		showOnEmpty:   showOnEmpty,
 	}
+	manager.SetProvider(provider)
+	return manager
 }
 
-// SetSuggestions updates all available suggestions
+// NewScoringManager creates a suggestion manager that ranks suggestions with scoreFunc, best match first,
+// and highlights the matched characters (shows suggestions on empty input). A nil scoreFunc uses FuzzyScore
+func NewScoringManager(suggestions []string, maxVisible int, scoreFunc ScoreFunc) *Manager {
+	if scoreFunc == nil {
+		scoreFunc = FuzzyScore
+	}
+
+	manager := NewManager(suggestions, maxVisible, nil)
+	manager.scoreFunc = scoreFunc
+	return manager
+}
+
+// SetSuggestions replaces the provider with a fixed list of suggestions
 func (sm *Manager) SetSuggestions(suggestions []string) {
-	sm.allSuggestions = suggestions
-	sm.filteredSuggestions = suggestions
-	sm.selectedIndex = 0
 	sm.lastInput = ""
+	sm.SetProvider(StaticProvider(suggestions))
+}
+
+// SetDescribeFunc sets the text rendered next to each suggestion. Nil renders the suggestions alone.
+func (sm *Manager) SetDescribeFunc(describeFunc DescribeFunc) {
+	sm.describeFunc = describeFunc
+}
+
+// SetBoostFunc sets the bonus that moves suggestions up the list. Boosted suggestions come first
+// on empty input, and matches are ranked by score plus boost. Nil keeps the plain order.
+func (sm *Manager) SetBoostFunc(boostFunc BoostFunc) {
+	sm.boostFunc = boostFunc
+	sm.filteredSuggestions = sm.boosted(sm.filteredSuggestions)
 }
 
 // UpdateFilter filters suggestions based on input and resets selection only if input changed
@@ -57,21 +112,81 @@ func (sm *Manager) UpdateFilter(input string) {
 		return
 	}
 
+	sm.filter(input)
+}
+
+// filter filters the loaded and extra suggestions with input and resets the selection
+func (sm *Manager) filter(input string) {
 	sm.lastInput = input
+	sm.matchPositions = nil
+
+	candidates := sm.allSuggestions
+	if len(sm.extraSuggestions) > 0 {
+		candidates = lo.Uniq(append(slices.Clone(sm.allSuggestions), sm.extraSuggestions...))
+	}
 
 	if input == "" {
-		sm.filteredSuggestions = sm.allSuggestions
+		sm.filteredSuggestions = sm.boosted(candidates)
+	} else if sm.scoreFunc != nil {
+		sm.rankSuggestions(candidates, input)
 	} else {
-		sm.filteredSuggestions = make([]string, 0)
-		sm.filteredSuggestions = lo.Filter(sm.allSuggestions, func(suggestion string, _ int) bool {
+		sm.filteredSuggestions = sm.boosted(lo.Filter(candidates, func(suggestion string, _ int) bool {
 			return sm.filterFunc(suggestion, input)
-		})
+		}))
 	}
 
-	// Reset selection only when filter actually changes
 	sm.selectedIndex = 0
 }
 
+// rankSuggestions keeps the suggestions matching input, best score first.
+// Equal scores prefer shorter suggestions, then keep the original order
+func (sm *Manager) rankSuggestions(candidates []string, input string) {
+	type rankedSuggestion struct {
+		suggestion string
+		score      int
+	}
+
+	var ranked []rankedSuggestion
+	sm.matchPositions = make(map[string][]int)
+
+	for _, suggestion := range candidates {
+		if score, positions, matched := sm.scoreFunc(suggestion, input); matched {
+			ranked = append(ranked, rankedSuggestion{suggestion: suggestion, score: score + sm.boost(suggestion)})
+			sm.matchPositions[suggestion] = positions
+		}
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].score != ranked[j].score {
+			return ranked[i].score > ranked[j].score
+		}
+		return len(ranked[i].suggestion) < len(ranked[j].suggestion)
+	})
+
+	sm.filteredSuggestions = lo.Map(ranked, func(r rankedSuggestion, _ int) string { return r.suggestion })
+}
+
+// boosted returns suggestions ordered by boost, highest first. Equal boosts keep their order
+func (sm *Manager) boosted(suggestions []string) []string {
+	if sm.boostFunc == nil {
+		return suggestions
+	}
+
+	ordered := slices.Clone(suggestions)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return sm.boostFunc(ordered[i]) > sm.boostFunc(ordered[j])
+	})
+	return ordered
+}
+
+// boost returns the boost of suggestion, 0 when no BoostFunc is set
+func (sm *Manager) boost(suggestion string) int {
+	if sm.boostFunc == nil {
+		return 0
+	}
+	return sm.boostFunc(suggestion)
+}
+
 // Next moves to the next suggestion (circular)
 func (sm *Manager) Next() {
 	visible := sm.GetVisible()
@@ -119,9 +234,9 @@ func (sm *Manager) ShouldShow(input string) bool {
 
 	visible := sm.GetVisible()
 
-	// Don't show if no suggestions
+	// Don't show if no suggestions, unless they are still loading
 	if len(visible) == 0 {
-		return false
+		return sm.Loading()
 	}
 
 	// Don't show if only one suggestion and it's an exact match
@@ -147,17 +262,66 @@ func (sm *Manager) ApplySelected(input *textinput.Model) {
 func (sm *Manager) Render() string {
 	visible := sm.GetVisible()
 	if len(visible) == 0 {
+		if sm.Loading() {
+			return styles.SuggestionContainerStyle.Render(styles.SuggestionItemStyle.Render(loadingText))
+		}
 		return ""
 	}
 
+	// Descriptions line up in a column after the longest visible suggestion
+	nameWidth := lo.Max(lo.Map(visible, func(suggestion string, _ int) int { return lipgloss.Width(suggestion) }))
+
 	suggestionLines := lo.Map(visible, func(suggestion string, i int) string {
+		rowStyle := styles.SuggestionItemStyle
 		if i == sm.selectedIndex {
-			return styles.SuggestionHighlightStyle.Render("• " + suggestion)
+			rowStyle = styles.SuggestionHighlightStyle
 		}
-		return styles.SuggestionItemStyle.Render("• " + suggestion)
+		textStyle := rowStyle.UnsetPaddingLeft()
+
+		line := textStyle.Render("• ") + sm.highlightMatches(suggestion, textStyle)
+		if sm.describeFunc != nil {
+			line += strings.Repeat(" ", nameWidth-lipgloss.Width(suggestion)+2) + sm.describeFunc(suggestion)
+		}
+
+		return lipgloss.NewStyle().
+			PaddingLeft(rowStyle.GetPaddingLeft()).
+			MaxWidth(suggestionLineWidth).
+			Render(line)
 	})
 
+	if sm.Loading() {
+		suggestionLines = append(suggestionLines, styles.SuggestionItemStyle.Render(loadingText))
+	}
+
 	return styles.SuggestionContainerStyle.Render(
 		lipgloss.JoinVertical(lipgloss.Left, suggestionLines...),
 	)
 }
+
+// highlightMatches renders a suggestion in style, with the characters matched by the last scored input
+// in SuggestionMatchStyle
+func (sm *Manager) highlightMatches(suggestion string, style lipgloss.Style) string {
+	positions := sm.matchPositions[suggestion]
+	if len(positions) == 0 {
+		return style.Render(suggestion)
+	}
+
+	// Render runs of matched and unmatched characters as whole segments
+	var rendered strings.Builder
+	runes := []rune(suggestion)
+	for start := 0; start < len(runes); {
+		matched := lo.Contains(positions, start)
+		end := start + 1
+		for end < len(runes) && lo.Contains(positions, end) == matched {
+			end++
+		}
+
+		segmentStyle := style
+		if matched {
+			segmentStyle = styles.SuggestionMatchStyle
+		}
+		rendered.WriteString(segmentStyle.Render(string(runes[start:end])))
+		start = end
+	}
+	return rendered.String()
+}
@@ -3,154 +3,118 @@ package suggestions
 import (
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"time"
 
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
 	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/samber/lo"
 )
 
-// PathManager handles autocomplete suggestions for filesystem paths with dynamic directory scanning
+// pathCacheTTL is how long the listing of a directory is reused before it is read again
+const pathCacheTTL = 10 * time.Second
+
+// PathManager handles autocomplete suggestions for filesystem paths. Paths are listed by a Provider,
+// off the UI goroutine, and cached per directory. Besides the directory being completed it suggests
+// the PathSources: bookmarks, task paths and git repositories, which also match by their name
 type PathManager struct {
 	*Manager
-	lastDirectory string // Last directory context to avoid unnecessary rescanning
+	provider      *pathProvider
+	frequentPaths map[string]int // Previously used paths in display format, with their boost
 }
 
 // NewPathManager creates a new path suggestion manager with filesystem-aware autocomplete
 func NewPathManager(maxVisible int) *PathManager {
-	// Use a custom filter that's optimized for paths but start with empty suggestions
-	baseManager := NewManagerWithOptions([]string{}, maxVisible, StartsWithFilter, false)
-
-	return &PathManager{
-		Manager:       baseManager,
-		lastDirectory: "",
-	}
-}
-
-// UpdateFilter overrides the base UpdateFilter to provide dynamic path suggestions
-func (pm *PathManager) UpdateFilter(inputText string) {
-	// Only process if input actually changed
-	if inputText == pm.lastInput {
-		return
+	provider := newPathProvider()
+	pm := &PathManager{
+		Manager:  NewProviderManager(provider, maxVisible, nil, false),
+		provider: provider,
 	}
 
-	// Get current directory context from input
-	currentDirectory := pm.getDirectoryContext(inputText)
-
-	// Only regenerate suggestions when directory context changes
-	if pm.shouldRegeneratePathSuggestions(currentDirectory) {
-		pathSuggestions := pm.generatePathSuggestions(inputText)
-		pm.SetSuggestions(pathSuggestions)
-		pm.lastDirectory = currentDirectory
-	}
+	pm.filterFunc = pm.matches
+	pm.SetCacheTTL(pathCacheTTL)
+	pm.SetDescribeFunc(pm.describe)
+	return pm
+}
 
-	// Update the last input and filter the suggestions manually
-	pm.lastInput = inputText
+// SetSources sets where paths are found besides the directory being completed
+func (pm *PathManager) SetSources(sources PathSources) {
+	pm.provider.setSources(sources)
+	pm.InvalidateAll()
+}
 
-	if inputText == "" {
-		pm.filteredSuggestions = pm.allSuggestions
-	} else {
-		pm.filteredSuggestions = lo.Filter(pm.allSuggestions, func(suggestion string, _ int) bool {
-			return pm.filterFunc(suggestion, inputText)
-		})
+// SetFrequentPaths suggests previously used paths matching the input, even outside the listed directory,
+// and ranks every suggestion by the boost of its path. Relative paths are skipped since they depend on the cwd.
+func (pm *PathManager) SetFrequentPaths(boosts map[string]int) {
+	pm.frequentPaths = make(map[string]int, len(boosts))
+	for path, boost := range boosts {
+		if displayPath, ok := displayDirectory(path); ok {
+			pm.frequentPaths[displayPath] = max(pm.frequentPaths[displayPath], boost)
+		}
 	}
 
-	// Reset selection when filter changes
-	pm.selectedIndex = 0
+	pm.extraSuggestions = lo.Keys(pm.frequentPaths)
+	slices.Sort(pm.extraSuggestions)
+	pm.SetBoostFunc(func(suggestion string) int { return pm.frequentPaths[suggestion] })
 }
 
-// getDirectoryContext extracts the directory portion of the input path for context comparison
-func (pm *PathManager) getDirectoryContext(inputText string) string {
-	if inputText == "" {
-		return "."
+// matches applies pathFilter. Paths from a source also match by their name while the input
+// has no separator, so "api" finds ~/code/api/
+func (pm *PathManager) matches(suggestion, input string) bool {
+	if pathFilter(suggestion, input) {
+		return true
 	}
-
-	// Expand ~ to home directory for consistent comparison
-	expandedPath := pm.expandPath(inputText)
-
-	// If path ends with separator or is a special directory, use as-is
-	if strings.HasSuffix(expandedPath, string(filepath.Separator)) || expandedPath == "." || expandedPath == ".." {
-		return expandedPath
+	if strings.ContainsRune(input, filepath.Separator) || pm.sourceLabel(suggestion) == "" {
+		return false
 	}
 
-	// Otherwise return the directory part
-	return filepath.Dir(expandedPath)
+	name := filepath.Base(strings.TrimSuffix(suggestion, string(filepath.Separator)))
+	return StartsWithFilter(name, input)
 }
 
-// shouldRegeneratePathSuggestions determines if filesystem scanning is needed
-func (pm *PathManager) shouldRegeneratePathSuggestions(currentDirectory string) bool {
-	// Always regenerate on first run
-	if pm.lastDirectory == "" {
-		return true
+// describe marks git repositories with their branch and sourced paths with their source
+func (pm *PathManager) describe(suggestion string) string {
+	var marks []string
+	if branch, isRepository := pm.provider.branch(suggestion); isRepository {
+		marks = append(marks, "⎇ "+branch)
 	}
-
-	// Regenerate if directory context changed
-	return pm.lastDirectory != currentDirectory
+	if label := pm.sourceLabel(suggestion); label != "" {
+		marks = append(marks, label)
+	}
+	return styles.LightGrayStyle.Render(strings.Join(marks, " · "))
 }
 
-// generatePathSuggestions creates directory suggestions based on filesystem contents
-func (pm *PathManager) generatePathSuggestions(inputText string) []string {
-	if inputText == "" {
-		inputText = "."
+// sourceLabel returns where a suggestion comes from, empty when it was listed from the directory
+func (pm *PathManager) sourceLabel(suggestion string) string {
+	if label := pm.provider.label(suggestion); label != "" {
+		return label
 	}
-
-	// Expand ~ to home directory
-	expandedPath := pm.expandPath(inputText)
-
-	// Determine search directory and match prefix
-	var searchDirectory, matchPrefix string
-
-	if strings.HasSuffix(expandedPath, string(filepath.Separator)) || expandedPath == "." || expandedPath == ".." {
-		// Input ends with separator or is current/parent dir - search inside
-		searchDirectory = expandedPath
-		matchPrefix = ""
-	} else {
-		// Extract directory and filename prefix for filtering
-		searchDirectory = filepath.Dir(expandedPath)
-		matchPrefix = strings.ToLower(filepath.Base(expandedPath))
+	if _, frequent := pm.frequentPaths[suggestion]; frequent {
+		return recentLabel
 	}
+	return ""
+}
 
-	// Read directory contents
-	directoryEntries, err := os.ReadDir(searchDirectory)
-	if err != nil {
-		return []string{}
+// pathFilter matches paths starting with the input (case-insensitive). Hidden directories
+// are only suggested once the user types their leading dot
+var pathFilter FilterFunc = func(suggestion, input string) bool {
+	if !StartsWithFilter(suggestion, input) {
+		return false
 	}
 
-	var pathSuggestions []string
-
-	for _, entry := range directoryEntries {
-		if !entry.IsDir() {
-			continue // Only include directories
-		}
-
-		entryName := entry.Name()
-
-		// Skip hidden directories unless user explicitly types dot
-		if strings.HasPrefix(entryName, ".") && !strings.HasPrefix(matchPrefix, ".") {
-			continue
-		}
-
-		// Filter by prefix if provided
-		if matchPrefix != "" && !strings.HasPrefix(strings.ToLower(entryName), matchPrefix) {
-			continue
-		}
-
-		// Build full path suggestion
-		fullSuggestionPath := filepath.Join(searchDirectory, entryName)
-
-		// Convert back to display format (with ~ if applicable)
-		displayPath := pm.contractPath(fullSuggestionPath)
-
-		// Add trailing separator to indicate directory
-		displayPath += string(filepath.Separator)
-
-		pathSuggestions = append(pathSuggestions, displayPath)
+	name := filepath.Base(strings.TrimSuffix(suggestion, string(filepath.Separator)))
+	if !strings.HasPrefix(name, ".") {
+		return true
 	}
 
-	return pathSuggestions
+	typedName := input[strings.LastIndex(input, string(filepath.Separator))+1:]
+	return strings.HasPrefix(typedName, ".")
 }
 
 // expandPath expands ~ to home directory for filesystem operations
-func (pm *PathManager) expandPath(path string) string {
+func expandPath(path string) string {
 	if !strings.HasPrefix(path, "~/") && path != "~" {
 		return path
 	}
@@ -167,24 +131,23 @@ func (pm *PathManager) expandPath(path string) string {
 	return filepath.Join(homeDirectory, path[2:])
 }
 
-// ApplySelected applies the selected path suggestion to the textinput with path-specific handling
-func (pm *PathManager) ApplySelected(input *textinput.Model) {
+// ApplySelected applies the selected path suggestion to the textinput and queries the suggestions
+// inside it, so they stay relevant if the user continues typing
+func (pm *PathManager) ApplySelected(input *textinput.Model) tea.Cmd {
 	selectedPath := pm.GetSelected()
 	if selectedPath == "" {
-		return
+		return nil
 	}
 
 	input.SetValue(selectedPath)
 	input.SetCursor(len(selectedPath))
 	pm.Reset()
 
-	// After applying a path, update suggestions for the new context
-	// This ensures that if user continues typing, suggestions remain relevant
-	pm.UpdateFilter(selectedPath)
+	return pm.Query(selectedPath)
 }
 
 // contractPath converts absolute path back to ~ format if under home directory
-func (pm *PathManager) contractPath(path string) string {
+func contractPath(path string) string {
 	homeDirectory, err := os.UserHomeDir()
 	if err != nil {
 		return path
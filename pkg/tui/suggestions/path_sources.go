@@ -0,0 +1,288 @@
+package suggestions
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/samber/lo"
+)
+
+// Limits of the git repository scan
+const (
+	repositoryScanDepth = 3   // Directory levels searched below every search root
+	maxRepositories     = 500 // Repositories kept from all search roots
+)
+
+// Labels rendered next to the paths that come from a source rather than from the listed directory
+const (
+	bookmarkLabel   = "bookmark"
+	taskLabel       = "task"
+	repositoryLabel = "repo"
+	recentLabel     = "recent"
+)
+
+// skippedScanDirectories are never searched for repositories
+var skippedScanDirectories = []string{"node_modules", "vendor", "target", "dist", "build"}
+
+// PathSources lists where PathManager finds paths besides the directory being completed
+type PathSources struct {
+	SearchRoots  []string // Directories searched for git repository roots, such as ~/code
+	Bookmarks    []string // Paths the user bookmarked
+	TaskPaths    []string // Paths used by saved tasks
+	IncludeFiles bool     // Also suggest files, for tasks that point at a script
+}
+
+// pathProvider lists the directory being completed and adds the paths of the PathSources.
+// Loads run off the UI goroutine, so the labels and branches it records are guarded by mu
+type pathProvider struct {
+	mu           sync.RWMutex
+	sources      PathSources
+	repositories []string          // Repository roots found under the search roots, in display format
+	scanned      bool              // Whether the search roots were scanned
+	labels       map[string]string // Source label of every sourced path
+	branches     map[string]string // Git branch of every path that is a repository root
+}
+
+// newPathProvider creates a path provider without sources.
+func newPathProvider() *pathProvider {
+	return &pathProvider{
+		labels:   make(map[string]string),
+		branches: make(map[string]string),
+	}
+}
+
+// setSources replaces the sources. Search roots are scanned again on the next load
+func (p *pathProvider) setSources(sources PathSources) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.sources = sources
+	p.repositories = nil
+	p.scanned = false
+	p.labels = make(map[string]string)
+}
+
+// Key returns the directory the input completes in: the input itself when it ends with a separator,
+// its parent otherwise
+func (p *pathProvider) Key(input string) string {
+	if input == "" {
+		return "."
+	}
+
+	// Expand ~ to home directory for consistent comparison
+	expandedPath := expandPath(input)
+
+	if strings.HasSuffix(expandedPath, string(filepath.Separator)) || expandedPath == "." || expandedPath == ".." {
+		return filepath.Clean(expandedPath)
+	}
+	return filepath.Dir(expandedPath)
+}
+
+// Load lists directory and adds the sourced paths. A directory that cannot be read, such as
+// the parent of a repository name being typed, still gets the sourced paths
+func (p *pathProvider) Load(ctx context.Context, directory string) ([]string, error) {
+	p.mu.RLock()
+	sources, scanned := p.sources, p.scanned
+	p.mu.RUnlock()
+
+	pathSuggestions := p.listDirectory(directory, sources.IncludeFiles)
+
+	if !scanned {
+		repositories := scanRepositories(ctx, sources.SearchRoots)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		p.mu.Lock()
+		p.repositories, p.scanned = repositories, true
+		p.mu.Unlock()
+	}
+
+	return lo.Uniq(append(pathSuggestions, p.sourcedPaths(sources)...)), nil
+}
+
+// listDirectory returns the subdirectories of directory in display format (with ~ if applicable)
+// with a trailing separator, and its files when includeFiles is set
+func (p *pathProvider) listDirectory(directory string, includeFiles bool) []string {
+	directoryEntries, err := os.ReadDir(directory)
+	if err != nil {
+		return nil
+	}
+
+	var pathSuggestions []string
+	for _, entry := range directoryEntries {
+		fullPath := filepath.Join(directory, entry.Name())
+
+		if !entry.IsDir() {
+			if includeFiles {
+				pathSuggestions = append(pathSuggestions, contractPath(fullPath))
+			}
+			continue
+		}
+
+		displayPath := contractPath(fullPath) + string(filepath.Separator)
+		p.recordBranch(displayPath, fullPath)
+		pathSuggestions = append(pathSuggestions, displayPath)
+	}
+	return pathSuggestions
+}
+
+// sourcedPaths returns the bookmarks, task paths and repositories, labelling each with its source.
+// A path from several sources keeps the first label
+func (p *pathProvider) sourcedPaths(sources PathSources) []string {
+	p.mu.RLock()
+	repositories := p.repositories
+	p.mu.RUnlock()
+
+	groups := []struct {
+		label string
+		paths []string
+	}{
+		{bookmarkLabel, sources.Bookmarks},
+		{taskLabel, sources.TaskPaths},
+		{repositoryLabel, repositories},
+	}
+
+	var sourced []string
+	labels := make(map[string]string)
+	for _, group := range groups {
+		for _, path := range group.paths {
+			displayPath, ok := displayDirectory(path)
+			if !ok {
+				continue
+			}
+			if info, err := os.Stat(expandPath(strings.TrimSpace(path))); err == nil && !info.IsDir() {
+				displayPath = strings.TrimSuffix(displayPath, string(filepath.Separator))
+			}
+			if _, labelled := labels[displayPath]; !labelled {
+				labels[displayPath] = group.label
+				sourced = append(sourced, displayPath)
+			}
+			p.recordBranch(displayPath, expandPath(displayPath))
+		}
+	}
+
+	p.mu.Lock()
+	p.labels = labels
+	p.mu.Unlock()
+
+	return sourced
+}
+
+// recordBranch remembers the git branch of fullPath under displayPath when it is a repository root
+func (p *pathProvider) recordBranch(displayPath, fullPath string) {
+	branch, isRepository := gitBranch(fullPath)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if isRepository {
+		p.branches[displayPath] = branch
+	} else {
+		delete(p.branches, displayPath)
+	}
+}
+
+// label returns the source label of a path, empty for paths of the listed directory
+func (p *pathProvider) label(displayPath string) string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.labels[displayPath]
+}
+
+// branch returns the git branch of a path, false when it is not a repository root
+func (p *pathProvider) branch(displayPath string) (string, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	branch, found := p.branches[displayPath]
+	return branch, found
+}
+
+// scanRepositories searches the roots for git repository roots, repositoryScanDepth levels deep.
+// Hidden and dependency directories are skipped and repositories are not searched for nested ones
+func scanRepositories(ctx context.Context, roots []string) []string {
+	var repositories []string
+
+	var scan func(directory string, depth int)
+	scan = func(directory string, depth int) {
+		if ctx.Err() != nil || len(repositories) >= maxRepositories {
+			return
+		}
+		if _, isRepository := gitBranch(directory); isRepository {
+			repositories = append(repositories, contractPath(directory))
+			return
+		}
+		if depth == repositoryScanDepth {
+			return
+		}
+
+		entries, err := os.ReadDir(directory)
+		if err != nil {
+			return
+		}
+		for _, entry := range entries {
+			name := entry.Name()
+			if entry.IsDir() && !strings.HasPrefix(name, ".") && !lo.Contains(skippedScanDirectories, name) {
+				scan(filepath.Join(directory, name), depth+1)
+			}
+		}
+	}
+
+	for _, root := range roots {
+		if expandedRoot := expandPath(strings.TrimSpace(root)); filepath.IsAbs(expandedRoot) {
+			scan(filepath.Clean(expandedRoot), 0)
+		}
+	}
+	return repositories
+}
+
+// gitBranch returns the checked out branch of the repository rooted at directory, or the short
+// commit hash when the HEAD is detached. It returns false when directory is not a repository root
+func gitBranch(directory string) (string, bool) {
+	gitPath := filepath.Join(directory, ".git")
+	info, err := os.Stat(gitPath)
+	if err != nil {
+		return "", false
+	}
+
+	// Worktrees and submodules have a .git file pointing at the real git directory
+	if !info.IsDir() {
+		content, err := os.ReadFile(gitPath)
+		if err != nil {
+			return "", true
+		}
+		gitDir := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(string(content)), "gitdir:"))
+		if !filepath.IsAbs(gitDir) {
+			gitDir = filepath.Join(directory, gitDir)
+		}
+		gitPath = gitDir
+	}
+
+	head, err := os.ReadFile(filepath.Join(gitPath, "HEAD"))
+	if err != nil {
+		return "", true
+	}
+
+	ref := strings.TrimSpace(string(head))
+	if branch, found := strings.CutPrefix(ref, "ref: refs/heads/"); found {
+		return branch, true
+	}
+	return ref[:min(len(ref), 7)], true
+}
+
+// displayDirectory converts a directory path to the format of the suggestions: ~ for the home directory
+// and a trailing separator. Relative paths are rejected since they depend on the cwd
+func displayDirectory(path string) (string, bool) {
+	expandedPath := expandPath(strings.TrimSpace(path))
+	if !filepath.IsAbs(expandedPath) {
+		return "", false
+	}
+
+	displayPath := contractPath(filepath.Clean(expandedPath))
+	if !strings.HasSuffix(displayPath, string(filepath.Separator)) {
+		displayPath += string(filepath.Separator)
+	}
+	return displayPath, true
+}
@@ -0,0 +1,173 @@
+package suggestions
+
+import (
+	"context"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Provider loads the suggestions of a Manager. Inputs mapping to the same key share one load,
+// such as every input completing inside the same directory.
+type Provider interface {
+	// Key returns the cache key of the suggestions needed for input
+	Key(input string) string
+
+	// Load returns the suggestions of key. It runs in a tea.Cmd, off the UI goroutine,
+	// and should stop early once ctx is cancelled by a newer query
+	Load(ctx context.Context, key string) ([]string, error)
+}
+
+// StaticProvider provides a fixed list of suggestions. Managers load it right away, without a tea.Cmd
+type StaticProvider []string
+
+// Key returns the same key for every input
+func (sp StaticProvider) Key(string) string {
+	return ""
+}
+
+// Load returns the list
+func (sp StaticProvider) Load(context.Context, string) ([]string, error) {
+	return sp, nil
+}
+
+// LoadedMsg carries the suggestions loaded by a query. Pass it to Manager.Update
+type LoadedMsg struct {
+	owner       *Manager
+	query       int
+	key         string
+	suggestions []string
+	err         error
+}
+
+// pendingQuery is the load in flight of a Manager
+type pendingQuery struct {
+	id     int
+	key    string
+	cancel context.CancelFunc
+}
+
+// cacheEntry holds the loaded suggestions of a key
+type cacheEntry struct {
+	suggestions []string
+	loadedAt    time.Time
+}
+
+// SetProvider replaces the source of the suggestions, dropping the cache and any load in flight
+func (sm *Manager) SetProvider(provider Provider) {
+	sm.cancelPending()
+	sm.provider = provider
+	sm.cache = make(map[string]cacheEntry)
+	sm.activeKey = ""
+	sm.allSuggestions = nil
+
+	if static, ok := provider.(StaticProvider); ok {
+		sm.cache[""] = cacheEntry{suggestions: static, loadedAt: time.Now()}
+		sm.allSuggestions = static
+	}
+
+	sm.filter(sm.lastInput)
+}
+
+// SetCacheTTL sets how long loaded suggestions are reused. Older suggestions stay visible
+// while they are loaded again. Zero keeps them until invalidated
+func (sm *Manager) SetCacheTTL(ttl time.Duration) {
+	sm.cacheTTL = ttl
+}
+
+// Query filters the suggestions for input, loading them from the provider when they are not cached.
+// The returned command delivers them as a LoadedMsg, nil when nothing has to be loaded
+func (sm *Manager) Query(input string) tea.Cmd {
+	if !sm.showOnEmpty && input == "" {
+		sm.UpdateFilter(input)
+		return nil
+	}
+
+	key := sm.provider.Key(input)
+	if key != sm.activeKey {
+		sm.activeKey = key
+		sm.allSuggestions = sm.cache[key].suggestions
+		sm.filter(input)
+	} else {
+		sm.UpdateFilter(input)
+	}
+
+	return sm.load(key)
+}
+
+// Update stores the suggestions of a LoadedMsg sent for this manager and filters them with the last input.
+// It reports whether msg belonged to the manager. Results of stale queries are dropped
+func (sm *Manager) Update(msg tea.Msg) bool {
+	loaded, ok := msg.(LoadedMsg)
+	if !ok || loaded.owner != sm {
+		return false
+	}
+	if sm.pending == nil || sm.pending.id != loaded.query {
+		return true
+	}
+
+	sm.pending = nil
+
+	// A failed load is cached as empty, so an unreadable key is not loaded again on every keystroke
+	if loaded.err != nil {
+		loaded.suggestions = nil
+	}
+
+	sm.cache[loaded.key] = cacheEntry{suggestions: loaded.suggestions, loadedAt: time.Now()}
+	if loaded.key == sm.activeKey {
+		sm.allSuggestions = loaded.suggestions
+		sm.filter(sm.lastInput)
+	}
+	return true
+}
+
+// Loading reports whether the suggestions of the last input are being loaded
+func (sm *Manager) Loading() bool {
+	return sm.pending != nil && sm.pending.key == sm.activeKey
+}
+
+// Invalidate drops the cached suggestions of key, so the next query loads them again
+func (sm *Manager) Invalidate(key string) {
+	if _, static := sm.provider.(StaticProvider); static {
+		return
+	}
+	delete(sm.cache, key)
+}
+
+// InvalidateAll drops every cached suggestion
+func (sm *Manager) InvalidateAll() {
+	for key := range sm.cache {
+		sm.Invalidate(key)
+	}
+}
+
+// load starts loading key unless it is cached and fresh or already loading.
+// A load of another key still in flight is cancelled
+func (sm *Manager) load(key string) tea.Cmd {
+	if entry, cached := sm.cache[key]; cached && (sm.cacheTTL == 0 || time.Since(entry.loadedAt) < sm.cacheTTL) {
+		return nil
+	}
+	if sm.pending != nil && sm.pending.key == key {
+		return nil
+	}
+
+	sm.cancelPending()
+	sm.queries++
+	ctx, cancel := context.WithCancel(context.Background())
+	sm.pending = &pendingQuery{id: sm.queries, key: key, cancel: cancel}
+
+	owner, provider, query := sm, sm.provider, sm.queries
+	return func() tea.Msg {
+		defer cancel()
+		suggestions, err := provider.Load(ctx, key)
+		return LoadedMsg{owner: owner, query: query, key: key, suggestions: suggestions, err: err}
+	}
+}
+
+// cancelPending cancels the load in flight, if any
+func (sm *Manager) cancelPending() {
+	if sm.pending != nil {
+		sm.pending.cancel()
+		sm.pending = nil
+	}
+}
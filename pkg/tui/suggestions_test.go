@@ -1,6 +1,11 @@
 package tui
 
 import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/DieGopherLT/vscode-terminal-runner/pkg/tui/suggestions"
@@ -312,3 +317,374 @@ func TestSuggestionManager_Reset(t *testing.T) {
 		t.Errorf("expected selection to be 'option1' after reset, got '%s'", selection)
 	}
 }
+
+func TestFuzzyScore(t *testing.T) {
+	tests := []struct {
+		name              string
+		suggestion        string
+		input             string
+		expectedMatch     bool
+		expectedPositions []int
+	}{
+		{name: "empty input matches", suggestion: "bash", input: "", expectedMatch: true},
+		{name: "subsequence matches", suggestion: "terminal-bash", input: "tb", expectedMatch: true, expectedPositions: []int{0, 9}},
+		{name: "match is case-insensitive", suggestion: "Terminal-Bash", input: "BASH", expectedMatch: true, expectedPositions: []int{9, 10, 11, 12}},
+		{name: "tightest match is preferred", suggestion: "bxaxsxh-bash", input: "bash", expectedMatch: true, expectedPositions: []int{8, 9, 10, 11}},
+		{name: "characters out of order", suggestion: "bash", input: "hsab", expectedMatch: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			_, positions, matched := suggestions.FuzzyScore(tt.suggestion, tt.input)
+
+			// Assert
+			if matched != tt.expectedMatch {
+				t.Fatalf("FuzzyScore(%q, %q) matched = %v, expected %v", tt.suggestion, tt.input, matched, tt.expectedMatch)
+			}
+			if !slices.Equal(positions, tt.expectedPositions) {
+				t.Errorf("FuzzyScore(%q, %q) positions = %v, expected %v", tt.suggestion, tt.input, positions, tt.expectedPositions)
+			}
+		})
+	}
+}
+
+func TestSuggestionManager_Scoring(t *testing.T) {
+	tests := []struct {
+		name            string
+		suggestions     []string
+		input           string
+		expectedResults []string
+	}{
+		{
+			name:            "word start ranks above scattered letters",
+			suggestions:     []string{"lib-canvas-patch", "rebase-push", "terminal-bash"},
+			input:           "bash",
+			expectedResults: []string{"terminal-bash", "rebase-push", "lib-canvas-patch"},
+		},
+		{
+			name:            "prefix ranks above word start",
+			suggestions:     []string{"terminal-debian", "debug", "debug-alt"},
+			input:           "deb",
+			expectedResults: []string{"debug", "debug-alt", "terminal-debian"},
+		},
+		{
+			name:            "consecutive letters rank above gaps",
+			suggestions:     []string{"go-to-file", "gist", "git-commit"},
+			input:           "gi",
+			expectedResults: []string{"gist", "git-commit", "go-to-file"},
+		},
+		{
+			name:            "empty input keeps the original order",
+			suggestions:     []string{"zap", "add"},
+			input:           "",
+			expectedResults: []string{"zap", "add"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			manager := suggestions.NewScoringManager(tt.suggestions, 10, suggestions.FuzzyScore)
+
+			// Act
+			manager.UpdateFilter(tt.input)
+			results := manager.GetVisible()
+
+			// Assert
+			if !slices.Equal(results, tt.expectedResults) {
+				t.Errorf("expected %v, got %v", tt.expectedResults, results)
+			}
+		})
+	}
+}
+
+func TestSuggestionManager_Boost(t *testing.T) {
+	boosts := map[string]int{"terminal-powershell": 40, "debug": 10}
+
+	tests := []struct {
+		name            string
+		suggestions     []string
+		input           string
+		expectedResults []string
+	}{
+		{
+			name:            "boosted suggestions come first on empty input",
+			suggestions:     []string{"code", "debug", "terminal-powershell"},
+			input:           "",
+			expectedResults: []string{"terminal-powershell", "debug", "code"},
+		},
+		{
+			name:            "boost reorders close matches",
+			suggestions:     []string{"terminal", "terminal-bash", "terminal-powershell"},
+			input:           "term",
+			expectedResults: []string{"terminal-powershell", "terminal", "terminal-bash"},
+		},
+		{
+			name:            "boost never adds a suggestion that does not match",
+			suggestions:     []string{"code", "debug", "terminal-powershell"},
+			input:           "co",
+			expectedResults: []string{"code"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			manager := suggestions.NewScoringManager(tt.suggestions, 10, suggestions.FuzzyScore)
+			manager.SetBoostFunc(func(s string) int { return boosts[s] })
+
+			// Act
+			manager.UpdateFilter(tt.input)
+			results := manager.GetVisible()
+
+			// Assert
+			if !slices.Equal(results, tt.expectedResults) {
+				t.Errorf("expected %v, got %v", tt.expectedResults, results)
+			}
+		})
+	}
+}
+
+func TestClosest(t *testing.T) {
+	candidates := []string{"terminal", "terminal-bash", "terminal-powershell", "debug"}
+
+	tests := []struct {
+		name            string
+		input           string
+		expectedClosest string
+		expectedFound   bool
+	}{
+		{
+			name:            "typo within the edit distance",
+			input:           "termnal",
+			expectedClosest: "terminal",
+			expectedFound:   true,
+		},
+		{
+			name:            "case is ignored",
+			input:           "DEBUGG",
+			expectedClosest: "debug",
+			expectedFound:   true,
+		},
+		{
+			name:            "falls back to the best fuzzy match",
+			input:           "bash",
+			expectedClosest: "terminal-bash",
+			expectedFound:   true,
+		},
+		{
+			name:          "nothing close",
+			input:         "xyz",
+			expectedFound: false,
+		},
+		{
+			name:          "blank input",
+			input:         "  ",
+			expectedFound: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			closest, found := suggestions.Closest(candidates, tt.input)
+
+			// Assert
+			if found != tt.expectedFound || closest != tt.expectedClosest {
+				t.Errorf("expected (%q, %v), got (%q, %v)", tt.expectedClosest, tt.expectedFound, closest, found)
+			}
+		})
+	}
+}
+
+// dirProvider provides "<dir>/a" and "<dir>/b" for the text before the last slash, counting its loads.
+// Loading "missing/" fails
+type dirProvider struct {
+	loads *int
+}
+
+func (p dirProvider) Key(input string) string {
+	return input[:strings.LastIndex(input, "/")+1]
+}
+
+func (p dirProvider) Load(_ context.Context, key string) ([]string, error) {
+	*p.loads++
+	if key == "missing/" {
+		return nil, os.ErrNotExist
+	}
+	return []string{key + "a", key + "b"}, nil
+}
+
+func TestSuggestionManager_Provider(t *testing.T) {
+	t.Run("suggestions arrive through the command", func(t *testing.T) {
+		// Arrange
+		loads := 0
+		manager := suggestions.NewProviderManager(dirProvider{loads: &loads}, 10, nil, false)
+
+		// Act
+		cmd := manager.Query("x/")
+		loadingBefore := manager.Loading() && manager.ShouldShow("x/")
+		manager.Update(cmd())
+
+		// Assert
+		if !loadingBefore {
+			t.Errorf("expected the manager to show it is loading before the results arrive")
+		}
+		if manager.Loading() {
+			t.Errorf("expected loading to finish once the results arrive")
+		}
+		if results := manager.GetVisible(); !slices.Equal(results, []string{"x/a", "x/b"}) {
+			t.Errorf("expected [x/a x/b], got %v", results)
+		}
+	})
+
+	t.Run("results of a stale query are dropped", func(t *testing.T) {
+		// Arrange
+		loads := 0
+		manager := suggestions.NewProviderManager(dirProvider{loads: &loads}, 10, nil, false)
+		stale := manager.Query("old/")
+		latest := manager.Query("new/")
+
+		// Act
+		manager.Update(stale())
+		staleResults := slices.Clone(manager.GetVisible())
+		manager.Update(latest())
+
+		// Assert
+		if len(staleResults) != 0 {
+			t.Errorf("expected stale results to be dropped, got %v", staleResults)
+		}
+		if results := manager.GetVisible(); !slices.Equal(results, []string{"new/a", "new/b"}) {
+			t.Errorf("expected [new/a new/b], got %v", results)
+		}
+	})
+
+	t.Run("cached keys are not loaded again until invalidated", func(t *testing.T) {
+		// Arrange
+		loads := 0
+		manager := suggestions.NewProviderManager(dirProvider{loads: &loads}, 10, nil, false)
+		manager.Update(manager.Query("x/")())
+
+		// Act
+		cachedCmd := manager.Query("x/a")
+		manager.Invalidate("x/")
+		invalidatedCmd := manager.Query("x/")
+
+		// Assert
+		if cachedCmd != nil {
+			t.Errorf("expected no load for a cached key")
+		}
+		if results := manager.GetVisible(); !slices.Equal(results, []string{"x/a", "x/b"}) {
+			t.Errorf("expected invalidated suggestions to stay visible while reloading, got %v", results)
+		}
+		if invalidatedCmd == nil {
+			t.Errorf("expected an invalidated key to be loaded again")
+		}
+	})
+
+	t.Run("failed loads are cached as empty", func(t *testing.T) {
+		// Arrange
+		loads := 0
+		manager := suggestions.NewProviderManager(dirProvider{loads: &loads}, 10, nil, false)
+		manager.Update(manager.Query("missing/")())
+
+		// Act
+		cmd := manager.Query("missing/a")
+
+		// Assert
+		if cmd != nil || loads != 1 {
+			t.Errorf("expected a failed key to be loaded once, got %d loads", loads)
+		}
+		if manager.Loading() || len(manager.GetVisible()) != 0 {
+			t.Errorf("expected no suggestions and no loading indicator, got %v", manager.GetVisible())
+		}
+	})
+
+	t.Run("static lists need no command", func(t *testing.T) {
+		// Arrange
+		manager := suggestions.NewManager([]string{"code", "debug"}, 10, nil)
+
+		// Act
+		cmd := manager.Query("de")
+
+		// Assert
+		if cmd != nil {
+			t.Errorf("expected no command for a static list")
+		}
+		if results := manager.GetVisible(); !slices.Equal(results, []string{"debug"}) {
+			t.Errorf("expected [debug], got %v", results)
+		}
+	})
+}
+
+func TestPathManager_Sources(t *testing.T) {
+	// Arrange
+	root := t.TempDir()
+	t.Setenv("HOME", root)
+	for _, dir := range []string{"code/api/.git", "code/web/.git", "code/lib/node_modules/dep/.git", "marks/notes"} {
+		if err := os.MkdirAll(filepath.Join(root, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(root, "code/api/.git/HEAD"), []byte("ref: refs/heads/main\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	manager := suggestions.NewPathManager(10)
+	manager.SetSources(suggestions.PathSources{
+		SearchRoots: []string{"~/code"},
+		Bookmarks:   []string{"~/marks/notes"},
+	})
+
+	query := func(input string) []string {
+		if cmd := manager.Query(input); cmd != nil {
+			manager.Update(cmd())
+		}
+		return manager.GetVisible()
+	}
+
+	tests := []struct {
+		name            string
+		input           string
+		expectedResults []string
+	}{
+		{
+			name:            "repositories match by name",
+			input:           "ap",
+			expectedResults: []string{"~/code/api/"},
+		},
+		{
+			name:            "bookmarks match by name",
+			input:           "not",
+			expectedResults: []string{"~/marks/notes/"},
+		},
+		{
+			name:            "dependency directories are not scanned",
+			input:           "dep",
+			expectedResults: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			results := query(tt.input)
+
+			// Assert
+			if !slices.Equal(results, tt.expectedResults) {
+				t.Errorf("expected %v, got %v", tt.expectedResults, results)
+			}
+		})
+	}
+
+	t.Run("repositories show their branch", func(t *testing.T) {
+		// Act
+		query("ap")
+
+		// Assert
+		if rendered := manager.Render(); !strings.Contains(rendered, "main") {
+			t.Errorf("expected the branch in the rendered suggestions, got %q", rendered)
+		}
+	})
+}
@@ -0,0 +1,119 @@
+package tui
+
+import (
+	"time"
+
+	"github.com/DieGopherLT/vscode-terminal-runner/pkg/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// validationDebounce is how long typing must pause before the changed field is validated
+const validationDebounce = 400 * time.Millisecond
+
+// Validator returns the problem with a field value, empty when the value is valid.
+type Validator func(value string) string
+
+// ValueFunc returns the current value of a form field.
+type ValueFunc func(field int) string
+
+// ValidationMsg validates a field once typing paused. Pass it to FormValidator.Update.
+type ValidationMsg struct {
+	owner  *FormValidator
+	field  int
+	change int
+}
+
+// fieldValidation holds the validator of a field and its last result.
+type fieldValidation struct {
+	validator Validator
+	problem   string // Problem found by the last validation
+	shown     bool   // Whether the problem is rendered: the field was left, or typing paused
+	changes   int    // Number of changes, identifies the latest debounced validation
+}
+
+// FormValidator validates form fields as the user types: when a field loses focus,
+// or once typing pauses. Problems are rendered under their field, and Valid
+// tells whether the form can be submitted.
+type FormValidator struct {
+	fields map[int]*fieldValidation
+}
+
+// NewFormValidator creates a form validator without fields.
+func NewFormValidator() *FormValidator {
+	return &FormValidator{fields: make(map[int]*fieldValidation)}
+}
+
+// Register sets the validator of a field and checks its initial value without showing the problem,
+// so an incomplete form starts with its submit disabled but no errors on screen.
+func (fv *FormValidator) Register(field int, validator Validator, value string) {
+	fv.fields[field] = &fieldValidation{validator: validator, problem: validator(value)}
+}
+
+// Validate checks a field and shows its problem, such as when the field loses focus.
+func (fv *FormValidator) Validate(field int, value string) {
+	if state, found := fv.fields[field]; found {
+		state.problem = state.validator(value)
+		state.shown = true
+	}
+}
+
+// ValidateAll checks and shows every field, reporting whether the form is valid.
+func (fv *FormValidator) ValidateAll(value ValueFunc) bool {
+	for field := range fv.fields {
+		fv.Validate(field, value(field))
+	}
+	return fv.Valid()
+}
+
+// Changed records that a field changed. The returned command validates it once typing pauses.
+func (fv *FormValidator) Changed(field int) tea.Cmd {
+	state, found := fv.fields[field]
+	if !found {
+		return nil
+	}
+
+	state.changes++
+	msg := ValidationMsg{owner: fv, field: field, change: state.changes}
+	return tea.Tick(validationDebounce, func(time.Time) tea.Msg { return msg })
+}
+
+// Update validates the field of a ValidationMsg sent by this validator, unless it changed again since.
+// It reports whether msg belonged to the validator.
+func (fv *FormValidator) Update(msg tea.Msg, value ValueFunc) bool {
+	validation, ok := msg.(ValidationMsg)
+	if !ok || validation.owner != fv {
+		return false
+	}
+
+	if state, found := fv.fields[validation.field]; found && state.changes == validation.change {
+		fv.Validate(validation.field, value(validation.field))
+	}
+	return true
+}
+
+// Valid reports whether every field passed its last validation.
+func (fv *FormValidator) Valid() bool {
+	for _, state := range fv.fields {
+		if state.problem != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// Problem returns the shown problem of a field, empty when it is valid or was not validated yet.
+func (fv *FormValidator) Problem(field int) string {
+	if state, found := fv.fields[field]; found && state.shown {
+		return state.problem
+	}
+	return ""
+}
+
+// RenderProblem renders the shown problem of a field, to place under it. Empty when there is none.
+func (fv *FormValidator) RenderProblem(field int) string {
+	problem := fv.Problem(field)
+	if problem == "" {
+		return ""
+	}
+	return styles.FieldErrorStyle.Render(styles.ErrorIcon + " " + problem)
+}
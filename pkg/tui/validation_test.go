@@ -0,0 +1,93 @@
+package tui
+
+import (
+	"testing"
+)
+
+// requiredValidator rejects blank values
+func requiredValidator(value string) string {
+	if value == "" {
+		return "Value is required"
+	}
+	return ""
+}
+
+func TestFormValidator(t *testing.T) {
+	tests := []struct {
+		name            string
+		initialValue    string
+		validate        func(fv *FormValidator, values map[int]string)
+		expectedValid   bool
+		expectedProblem string
+	}{
+		{
+			name:            "register checks the initial value without showing the problem",
+			initialValue:    "",
+			validate:        func(fv *FormValidator, values map[int]string) {},
+			expectedValid:   false,
+			expectedProblem: "",
+		},
+		{
+			name:         "validate shows the problem",
+			initialValue: "",
+			validate: func(fv *FormValidator, values map[int]string) {
+				fv.Validate(0, "")
+			},
+			expectedValid:   false,
+			expectedProblem: "Value is required",
+		},
+		{
+			name:         "debounced validation checks the current value",
+			initialValue: "",
+			validate: func(fv *FormValidator, values map[int]string) {
+				msg := fv.Changed(0)()
+				values[0] = "typed"
+				fv.Update(msg, func(field int) string { return values[field] })
+			},
+			expectedValid:   true,
+			expectedProblem: "",
+		},
+		{
+			name:         "stale debounced validation is ignored",
+			initialValue: "valid",
+			validate: func(fv *FormValidator, values map[int]string) {
+				stale := fv.Changed(0)()
+				fv.Changed(0)
+				values[0] = ""
+				fv.Update(stale, func(field int) string { return values[field] })
+			},
+			expectedValid:   true,
+			expectedProblem: "",
+		},
+		{
+			name:         "validate all shows every problem",
+			initialValue: "valid",
+			validate: func(fv *FormValidator, values map[int]string) {
+				values[0] = ""
+				fv.ValidateAll(func(field int) string { return values[field] })
+			},
+			expectedValid:   false,
+			expectedProblem: "Value is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			validator := NewFormValidator()
+			validator.Register(0, requiredValidator, tt.initialValue)
+			values := map[int]string{0: tt.initialValue}
+
+			// Act
+			tt.validate(validator, values)
+
+			// Assert
+			if validator.Valid() != tt.expectedValid {
+				t.Errorf("expected Valid to be %v, got %v", tt.expectedValid, validator.Valid())
+			}
+			if problem := validator.Problem(0); problem != tt.expectedProblem {
+				t.Errorf("expected problem %q, got %q", tt.expectedProblem, problem)
+			}
+		})
+	}
+}